@@ -0,0 +1,76 @@
+package api
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"golang.org/x/mod/semver"
+)
+
+// DefaultKonnectivityServerPort is the port Kamaji exposes the Konnectivity server on.
+const DefaultKonnectivityServerPort = int32(8132)
+
+var imageTagRegexp = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+
+// DefaultAddons are deployed when a cluster doesn't choose its addons.
+func DefaultAddons() *Addons {
+	return &Addons{
+		Coredns: &Addon{Enabled: true},
+	}
+}
+
+// Validate checks the addon settings, settings are only accepted on enabled addons.
+func (a *Addons) Validate() error {
+	for name, addon := range map[string]*Addon{"coredns": a.Coredns, "kube_proxy": a.KubeProxy} {
+		if addon == nil {
+			continue
+		}
+
+		if !addon.Enabled && (addon.ImageRepository != nil || addon.ImageTag != nil) {
+			return errors.NewInvalidArgumentError(fmt.Sprintf("addons.%s: settings require the addon to be enabled", name))
+		}
+
+		if addon.ImageRepository != nil && *addon.ImageRepository == "" {
+			return errors.NewInvalidArgumentError(fmt.Sprintf("addons.%s.image_repository must be non empty", name))
+		}
+
+		if addon.ImageTag != nil && !imageTagRegexp.MatchString(*addon.ImageTag) {
+			return errors.NewInvalidArgumentError(fmt.Sprintf("addons.%s.image_tag %s is not a valid image tag", name, *addon.ImageTag))
+		}
+	}
+
+	if konnectivity := a.Konnectivity; konnectivity != nil {
+		if !konnectivity.Enabled && (konnectivity.ServerPort != nil || konnectivity.Version != nil) {
+			return errors.NewInvalidArgumentError("addons.konnectivity: settings require the addon to be enabled")
+		}
+
+		if konnectivity.ServerPort != nil && (*konnectivity.ServerPort < 1 || *konnectivity.ServerPort > 65535) {
+			return errors.NewInvalidArgumentError("addons.konnectivity.server_port must be between 1 and 65535")
+		}
+
+		if konnectivity.Version != nil && !semver.IsValid(*konnectivity.Version) {
+			return errors.NewInvalidArgumentError("addons.konnectivity.version is not a valid semver")
+		}
+	}
+
+	return nil
+}
+
+// toggles returns whether each addon set in a is enabled
+func (a *Addons) toggles() map[string]bool {
+	toggles := map[string]bool{}
+	if a.Coredns != nil {
+		toggles["coredns"] = a.Coredns.Enabled
+	}
+
+	if a.KubeProxy != nil {
+		toggles["kube_proxy"] = a.KubeProxy.Enabled
+	}
+
+	if a.Konnectivity != nil {
+		toggles["konnectivity"] = a.Konnectivity.Enabled
+	}
+
+	return toggles
+}
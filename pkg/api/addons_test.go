@@ -0,0 +1,38 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+)
+
+func Test_Addons_Validate(t *testing.T) {
+	valid := []*Addons{
+		{},
+		DefaultAddons(),
+		{
+			Coredns:      &Addon{Enabled: true, ImageRepository: ptr.To("registry.example.com/k8s"), ImageTag: ptr.To("v1.11.1")},
+			KubeProxy:    &Addon{Enabled: false},
+			Konnectivity: &KonnectivityAddon{Enabled: true, ServerPort: ptr.To(int32(8443)), Version: ptr.To("v0.0.32")},
+		},
+	}
+
+	for i, addons := range valid {
+		assert.NoError(t, addons.Validate(), "addons %d", i)
+	}
+
+	invalid := []*Addons{
+		{Coredns: &Addon{Enabled: false, ImageTag: ptr.To("v1.11.1")}},
+		{KubeProxy: &Addon{Enabled: true, ImageRepository: ptr.To("")}},
+		{KubeProxy: &Addon{Enabled: true, ImageTag: ptr.To("not a tag")}},
+		{Konnectivity: &KonnectivityAddon{Enabled: false, ServerPort: ptr.To(int32(8132))}},
+		{Konnectivity: &KonnectivityAddon{Enabled: true, ServerPort: ptr.To(int32(0))}},
+		{Konnectivity: &KonnectivityAddon{Enabled: true, Version: ptr.To("latest")}},
+	}
+
+	for i, addons := range invalid {
+		assert.True(t, errors.IsInvalidArgument(addons.Validate()), "addons %d", i)
+	}
+}
@@ -1,7 +1,11 @@
 package api
 
 import (
+	"net/http"
+
 	"github.com/go-logr/logr"
+	"github.com/labstack/echo/v4"
+	"github.com/nrz-incubator/malygos/pkg/audit"
 )
 
 type ApiImpl struct {
@@ -16,3 +20,47 @@ func NewApiImpl(logger logr.Logger,
 		manager: manager,
 	}
 }
+
+func (api *ApiImpl) isAllowed(c echo.Context, action, resource, region, id string) bool {
+	user := GetUserInfo(c)
+	if !user.HasScope(action, resource) {
+		return false
+	}
+
+	return api.manager.GetRBAC().IsAllowed(user, action, resource, region, id)
+}
+
+// auditEvent starts the audit event of a mutating call, handlers complete it as the region,
+// object ID or error become known and record it with recordAudit once the response is sent.
+func (api *ApiImpl) auditEvent(c echo.Context, action, resource, region, id string) *audit.Event {
+	user := GetUserInfo(c)
+	event := &audit.Event{
+		RequestID: c.Response().Header().Get(echo.HeaderXRequestID),
+		Actor:     user.Username,
+		Groups:    user.Groups,
+		Action:    action,
+		Resource:  resource,
+		Region:    region,
+		ID:        id,
+	}
+
+	if impersonator := GetImpersonator(c); impersonator != nil {
+		event.Impersonator = impersonator.Username
+	}
+
+	return event
+}
+
+// recordAudit sets the event outcome from the response status and records it.
+func (api *ApiImpl) recordAudit(c echo.Context, event *audit.Event) {
+	switch status := c.Response().Status; {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		event.Outcome = audit.OutcomeDenied
+	case status >= http.StatusBadRequest:
+		event.Outcome = audit.OutcomeFailure
+	default:
+		event.Outcome = audit.OutcomeSuccess
+	}
+
+	api.manager.GetAuditor().Record(event)
+}
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/nrz-incubator/malygos/pkg/audit"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+)
+
+func (api *ApiImpl) ListAuditEvents(c echo.Context, params ListAuditEventsParams) error {
+	if !api.isAllowed(c, "list", "audit", "", "") {
+		return c.JSON(http.StatusForbidden, nil)
+	}
+
+	filter := &audit.Filter{}
+	if params.Actor != nil {
+		filter.Actor = *params.Actor
+	}
+
+	if params.Resource != nil {
+		filter.Resource = *params.Resource
+	}
+
+	if params.Since != nil {
+		filter.Since = *params.Since
+	}
+
+	if params.Until != nil {
+		filter.Until = *params.Until
+	}
+
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+
+	events, err := api.manager.GetAuditor().Query(filter)
+	if err != nil {
+		if errors.IsInvalidArgument(err) {
+			return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+		}
+
+		if errors.IsNotImplemented(err) {
+			return c.JSON(http.StatusNotImplemented, Error{Error: "the audit log is not queryable, configure an audit file"})
+		}
+
+		api.logger.Error(err, "failed to query audit events")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	resp := ListAuditEventsResponse{
+		JSON200: &struct {
+			Events []AuditEvent `json:"events"`
+		}{
+			Events: make([]AuditEvent, 0, len(events)),
+		},
+	}
+
+	for _, event := range events {
+		resp.JSON200.Events = append(resp.JSON200.Events, auditEventToAPI(&event))
+	}
+
+	return c.JSON(http.StatusOK, resp.JSON200)
+}
+
+func auditEventToAPI(event *audit.Event) AuditEvent {
+	apiEvent := AuditEvent{
+		Time:     event.Time,
+		Actor:    event.Actor,
+		Action:   event.Action,
+		Resource: event.Resource,
+		Outcome:  AuditEventOutcome(event.Outcome),
+	}
+
+	if event.RequestID != "" {
+		apiEvent.RequestId = &event.RequestID
+	}
+
+	if len(event.Groups) > 0 {
+		apiEvent.Groups = &event.Groups
+	}
+
+	if event.Impersonator != "" {
+		apiEvent.Impersonator = &event.Impersonator
+	}
+
+	if event.Region != "" {
+		apiEvent.Region = &event.Region
+	}
+
+	if event.ID != "" {
+		apiEvent.Id = &event.ID
+	}
+
+	if len(event.Details) > 0 {
+		apiEvent.Details = &event.Details
+	}
+
+	if event.Error != "" {
+		apiEvent.Error = &event.Error
+	}
+
+	return apiEvent
+}
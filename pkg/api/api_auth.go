@@ -0,0 +1,31 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (api *ApiImpl) CanI(c echo.Context, params CanIParams) error {
+	if params.Action == "" || params.Resource == "" {
+		return c.JSON(http.StatusBadRequest, Error{Error: "action and resource parameters are required"})
+	}
+
+	region := ""
+	if params.Region != nil {
+		region = *params.Region
+	}
+
+	id := ""
+	if params.Id != nil {
+		id = *params.Id
+	}
+
+	user := GetUserInfo(c)
+	groups := append([]string{}, user.Groups...)
+	return c.JSON(http.StatusOK, AccessReview{
+		Allowed:  api.isAllowed(c, params.Action, params.Resource, region, id),
+		Username: user.Username,
+		Groups:   &groups,
+	})
+}
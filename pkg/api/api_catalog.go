@@ -7,32 +7,57 @@ import (
 	"github.com/nrz-incubator/malygos/pkg/errors"
 )
 
-func (api *ApiImpl) ListCatalogComponents(c echo.Context) error {
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "list", "catalog_component") {
+func (api *ApiImpl) ListCatalogComponents(c echo.Context, params ListCatalogComponentsParams) error {
+	if !api.isAllowed(c, "list", "catalog_component", "", "") {
 		return c.JSON(http.StatusForbidden, nil)
 	}
 
-	catalog, err := api.manager.GetCatalog().ListComponents()
+	limit, err := pageLimit(params.Limit)
 	if err != nil {
+		return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+	}
+
+	options := PageOptions{Limit: limit}
+	if params.Continue != nil {
+		options.Continue = *params.Continue
+	}
+
+	components, next, err := api.manager.GetCatalog().ListComponents(options)
+	if err != nil {
+		if errors.IsInvalidArgument(err) {
+			return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+		}
+
 		return c.JSON(http.StatusInternalServerError, Error{Error: err.Error()})
 	}
 
+	catalog := Catalog{Components: components}
+	if next != "" {
+		catalog.Continue = &next
+	}
+
 	return c.JSON(http.StatusOK, catalog)
 }
 
 func (api *ApiImpl) AddCatalogComponent(c echo.Context) error {
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "create", "catalog_component") {
-		return c.JSON(http.StatusForbidden, nil)
-	}
+	event := api.auditEvent(c, "create", "catalog_component", "", "")
+	defer api.recordAudit(c, event)
 
 	var component CatalogComponent
 	if err := c.Bind(&component); err != nil {
+		event.Error = err.Error()
 		return c.JSON(http.StatusBadRequest, nil)
 	}
 
+	event.ID = component.Name
+	if !api.isAllowed(c, "create", "catalog_component", "", component.Name) {
+		return c.JSON(http.StatusForbidden, nil)
+	}
+
 	// TODO validate component
 	err := api.manager.GetCatalog().AddComponent(&component)
 	if err != nil {
+		event.Error = err.Error()
 		if errors.IsConflict(err) {
 			return c.JSON(http.StatusConflict, nil)
 		}
@@ -44,12 +69,16 @@ func (api *ApiImpl) AddCatalogComponent(c echo.Context) error {
 }
 
 func (api *ApiImpl) DeleteCatalogComponent(c echo.Context, componentName string) error {
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "delete", "catalog_component") {
+	event := api.auditEvent(c, "delete", "catalog_component", "", componentName)
+	defer api.recordAudit(c, event)
+
+	if !api.isAllowed(c, "delete", "catalog_component", "", componentName) {
 		return c.JSON(http.StatusForbidden, nil)
 	}
 
 	err := api.manager.GetCatalog().DeleteComponent(componentName)
 	if err != nil {
+		event.Error = err.Error()
 		if errors.IsNotFound(err) {
 			return c.JSON(http.StatusNotFound, nil)
 		}
@@ -60,7 +89,7 @@ func (api *ApiImpl) DeleteCatalogComponent(c echo.Context, componentName string)
 }
 
 func (api *ApiImpl) GetCatalogComponent(c echo.Context, componentName string) error {
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "get", "catalog_component") {
+	if !api.isAllowed(c, "get", "catalog_component", "", componentName) {
 		return c.JSON(http.StatusForbidden, nil)
 	}
 
@@ -76,19 +105,26 @@ func (api *ApiImpl) GetCatalogComponent(c echo.Context, componentName string) er
 }
 
 func (api *ApiImpl) AddCatalogComponentVersion(c echo.Context, componentName string) error {
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "create", "catalog_component_version") {
+	event := api.auditEvent(c, "create", "catalog_component_version", "", componentName)
+	defer api.recordAudit(c, event)
+
+	if !api.isAllowed(c, "create", "catalog_component_version", "", componentName) {
 		return c.JSON(http.StatusForbidden, nil)
 	}
 
 	var componentVersion CatalogComponentVersion
 	if err := c.Bind(&componentVersion); err != nil {
+		event.Error = err.Error()
 		return c.JSON(http.StatusBadRequest, nil)
 	}
 
+	event.Details = map[string]string{"version": componentVersion.Version}
+
 	// TODO validate componentVersion object
 
 	component, err := api.manager.GetCatalog().GetComponent(componentName)
 	if err != nil {
+		event.Error = err.Error()
 		if errors.IsNotFound(err) {
 			return c.JSON(http.StatusBadRequest, errors.NewNotFoundError("component", componentName))
 		}
@@ -100,6 +136,7 @@ func (api *ApiImpl) AddCatalogComponentVersion(c echo.Context, componentName str
 	}
 
 	if err := api.manager.GetCatalog().AddComponentVersion(componentName, &componentVersion); err != nil {
+		event.Error = err.Error()
 		if errors.IsConflict(err) {
 			return c.JSON(http.StatusConflict, nil)
 		}
@@ -111,7 +148,7 @@ func (api *ApiImpl) AddCatalogComponentVersion(c echo.Context, componentName str
 }
 
 func (api *ApiImpl) GetCatalogComponentVersion(c echo.Context, componentName string, componentVersion string) error {
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "get", "catalog_component_version") {
+	if !api.isAllowed(c, "get", "catalog_component_version", "", componentName) {
 		return c.JSON(http.StatusForbidden, nil)
 	}
 
@@ -127,12 +164,17 @@ func (api *ApiImpl) GetCatalogComponentVersion(c echo.Context, componentName str
 }
 
 func (api *ApiImpl) DeleteCatalogComponentVersion(c echo.Context, componentName string, componentVersion string) error {
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "delete", "catalog_component_version") {
+	event := api.auditEvent(c, "delete", "catalog_component_version", "", componentName)
+	event.Details = map[string]string{"version": componentVersion}
+	defer api.recordAudit(c, event)
+
+	if !api.isAllowed(c, "delete", "catalog_component_version", "", componentName) {
 		return c.JSON(http.StatusForbidden, nil)
 	}
 
 	err := api.manager.GetCatalog().DeleteComponentVersion(componentName, componentVersion)
 	if err != nil {
+		event.Error = err.Error()
 		if errors.IsNotFound(err) {
 			return c.JSON(http.StatusNotFound, nil)
 		}
@@ -144,12 +186,17 @@ func (api *ApiImpl) DeleteCatalogComponentVersion(c echo.Context, componentName
 
 func (api *ApiImpl) SubscribeCatalogComponentVersion(c echo.Context, componentName string, componentVersion string,
 	params SubscribeCatalogComponentVersionParams) error {
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "subscribe", "catalog_component_version") {
+	event := api.auditEvent(c, "subscribe", "catalog_component_version", params.Region, params.ClusterId)
+	event.Details = map[string]string{"component": componentName, "version": componentVersion}
+	defer api.recordAudit(c, event)
+
+	if !api.isAllowed(c, "subscribe", "catalog_component_version", params.Region, componentName) {
 		return c.JSON(http.StatusForbidden, nil)
 	}
 
 	err := api.manager.GetCatalog().SubscribeComponentVersion(params.Region, params.ClusterId, componentName, componentVersion)
 	if err != nil {
+		event.Error = err.Error()
 		if errors.IsConflict(err) {
 			return c.JSON(http.StatusConflict, nil)
 		}
@@ -161,7 +208,7 @@ func (api *ApiImpl) SubscribeCatalogComponentVersion(c echo.Context, componentNa
 }
 
 func (api *ApiImpl) ListCatalogComponentVersionSubscriptions(c echo.Context, componentName string, componentVersion string) error {
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "list", "catalog_component_version_subscription") {
+	if !api.isAllowed(c, "list", "catalog_component_version_subscription", "", componentName) {
 		return c.JSON(http.StatusForbidden, nil)
 	}
 
@@ -175,12 +222,17 @@ func (api *ApiImpl) ListCatalogComponentVersionSubscriptions(c echo.Context, com
 
 func (api *ApiImpl) UnsubscribeCatalogComponentVersion(c echo.Context, componentName string, componentVersion string,
 	params UnsubscribeCatalogComponentVersionParams) error {
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "unsubscribe", "catalog_component_version") {
+	event := api.auditEvent(c, "unsubscribe", "catalog_component_version", params.Region, params.ClusterId)
+	event.Details = map[string]string{"component": componentName, "version": componentVersion}
+	defer api.recordAudit(c, event)
+
+	if !api.isAllowed(c, "unsubscribe", "catalog_component_version", params.Region, componentName) {
 		return c.JSON(http.StatusForbidden, nil)
 	}
 
 	err := api.manager.GetCatalog().UnsubscribeComponentVersion(params.Region, params.ClusterId, componentName, componentVersion)
 	if err != nil {
+		event.Error = err.Error()
 		if errors.IsNotFound(err) {
 			return c.JSON(http.StatusNotFound, nil)
 		}
@@ -3,6 +3,8 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/nrz-incubator/malygos/pkg/errors"
@@ -11,16 +13,21 @@ import (
 
 func (api *ApiImpl) CreateRegistrarCluster(c echo.Context) error {
 	logger := api.logger
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "create", "managementcluster") {
-		return c.JSON(http.StatusForbidden, nil)
-	}
+	event := api.auditEvent(c, "create", "managementcluster", "", "")
+	defer api.recordAudit(c, event)
 
 	cluster := &RegistrarCluster{}
 	if err := c.Bind(cluster); err != nil {
 		logger.Error(err, "failed to bind request body on create cluster")
+		event.Error = err.Error()
 		return c.JSON(http.StatusBadRequest, nil)
 	}
 
+	event.Region = cluster.Region
+	if !api.isAllowed(c, "create", "managementcluster", cluster.Region, "") {
+		return c.JSON(http.StatusForbidden, nil)
+	}
+
 	if cluster.Kubeconfig == nil {
 		return c.JSON(http.StatusBadRequest, Error{Error: "kubeconfig field is required"})
 	}
@@ -39,15 +46,27 @@ func (api *ApiImpl) CreateRegistrarCluster(c echo.Context) error {
 
 	// validate kubeconfig
 	if _, err := clientcmd.NewClientConfigFromBytes([]byte(*cluster.Kubeconfig)); err != nil {
+		event.Error = err.Error()
 		return c.JSON(http.StatusBadRequest, Error{Error: fmt.Errorf("kubeconfig is invalid: %v", err).Error()})
 	}
 
-	regCluster, err := api.manager.GetClusterRegistrar().Create(&ClusterRegistrar{
+	registrar := &ClusterRegistrar{
 		Name:       cluster.Name,
 		Region:     cluster.Region,
 		Kubeconfig: *cluster.Kubeconfig,
-	})
+	}
+
+	if cluster.Provider != nil && *cluster.Provider != "" {
+		if !slices.Contains(api.manager.GetClusterProviders(), *cluster.Provider) {
+			return c.JSON(http.StatusBadRequest, Error{Error: fmt.Sprintf("provider %s is not supported, supported providers are %s",
+				*cluster.Provider, strings.Join(api.manager.GetClusterProviders(), ", "))})
+		}
+		registrar.Annotations = map[string]string{ClusterProviderAnnotation: *cluster.Provider}
+	}
+
+	regCluster, err := api.manager.GetClusterRegistrar().Create(registrar)
 	if err != nil {
+		event.Error = err.Error()
 		if errors.IsConflict(err) {
 			return c.JSON(http.StatusConflict, Error{Error: err.Error()})
 		}
@@ -56,27 +75,38 @@ func (api *ApiImpl) CreateRegistrarCluster(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, nil)
 	}
 
-	return c.JSON(http.StatusCreated, &RegistrarCluster{
-		Id:         &regCluster.Id,
-		Name:       regCluster.Name,
-		Kubeconfig: &regCluster.Kubeconfig,
-		Region:     regCluster.Region,
-	})
+	event.ID = regCluster.Id
+	return c.JSON(http.StatusCreated, toRegistrarCluster(regCluster))
 }
 
-func (api *ApiImpl) ListRegistrarClusters(c echo.Context) error {
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "list", "managementcluster") {
+func (api *ApiImpl) ListRegistrarClusters(c echo.Context, params ListRegistrarClustersParams) error {
+	if !api.isAllowed(c, "list", "managementcluster", "", "") {
 		return c.JSON(http.StatusForbidden, nil)
 	}
 
+	limit, err := pageLimit(params.Limit)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+	}
+
+	cursor, err := decodeListCursor(params.Continue)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+	}
+
 	clusters, err := api.manager.GetClusterRegistrar().List()
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, nil)
 	}
 
+	slices.SortFunc(clusters, func(a, b *ClusterRegistrar) int {
+		return strings.Compare(a.Region, b.Region)
+	})
+
 	resp := ListRegistrarClustersResponse{
 		JSON200: &struct {
 			Clusters []RegistrarCluster "json:\"clusters\""
+			Continue *string            "json:\"continue,omitempty\""
 			Warnings *[]string          "json:\"warnings,omitempty\""
 		}{
 			Clusters: []RegistrarCluster{},
@@ -85,19 +115,29 @@ func (api *ApiImpl) ListRegistrarClusters(c echo.Context) error {
 	}
 
 	for _, cluster := range clusters {
-		resp.JSON200.Clusters = append(resp.JSON200.Clusters, RegistrarCluster{
-			Id:         &cluster.Id,
-			Name:       cluster.Name,
-			Kubeconfig: &cluster.Kubeconfig,
-			Region:     cluster.Region,
-		})
+		if cursor != nil && cluster.Region <= cursor.Region {
+			continue
+		}
+
+		if !api.isAllowed(c, "list", "managementcluster", cluster.Region, "") {
+			continue
+		}
+
+		// the page is only cut when another registrar follows
+		if limit > 0 && int64(len(resp.JSON200.Clusters)) == limit {
+			last := resp.JSON200.Clusters[len(resp.JSON200.Clusters)-1]
+			resp.JSON200.Continue = (&listCursor{Region: last.Region}).encode()
+			break
+		}
+
+		resp.JSON200.Clusters = append(resp.JSON200.Clusters, *toRegistrarCluster(cluster))
 	}
 
 	return c.JSON(http.StatusOK, resp.JSON200)
 }
 
 func (api *ApiImpl) GetRegistrarCluster(c echo.Context, region string) error {
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "get", "managementcluster") {
+	if !api.isAllowed(c, "get", "managementcluster", region, "") {
 		return c.JSON(http.StatusForbidden, nil)
 	}
 
@@ -110,20 +150,36 @@ func (api *ApiImpl) GetRegistrarCluster(c echo.Context, region string) error {
 		return c.JSON(http.StatusInternalServerError, nil)
 	}
 
-	return c.JSON(http.StatusOK, &RegistrarCluster{
-		Id:         &cluster.Id,
-		Name:       cluster.Name,
-		Kubeconfig: &cluster.Kubeconfig,
-		Region:     cluster.Region,
-	})
+	if cluster == nil {
+		return c.JSON(http.StatusNotFound, nil)
+	}
+
+	return c.JSON(http.StatusOK, toRegistrarCluster(cluster))
 }
 
-func (api *ApiImpl) DeleteRegistrarCluster(c echo.Context, id string) error {
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "delete", "managementcluster") {
+func toRegistrarCluster(registrar *ClusterRegistrar) *RegistrarCluster {
+	provider := registrar.GetProvider()
+	return &RegistrarCluster{
+		Id:         &registrar.Id,
+		Name:       registrar.Name,
+		Kubeconfig: &registrar.Kubeconfig,
+		Region:     registrar.Region,
+		Provider:   &provider,
+	}
+}
+
+// DeleteRegistrarCluster deletes the management cluster of a region, registrars are addressed by
+// their region like in GetRegistrarCluster.
+func (api *ApiImpl) DeleteRegistrarCluster(c echo.Context, region string) error {
+	event := api.auditEvent(c, "delete", "managementcluster", region, "")
+	defer api.recordAudit(c, event)
+
+	if !api.isAllowed(c, "delete", "managementcluster", region, "") {
 		return c.JSON(http.StatusForbidden, nil)
 	}
 
-	if err := api.manager.GetClusterRegistrar().Delete(id); err != nil {
+	if err := api.manager.GetClusterRegistrar().Delete(region); err != nil {
+		event.Error = err.Error()
 		if errors.IsNotFound(err) {
 			return c.JSON(http.StatusNotFound, nil)
 		}
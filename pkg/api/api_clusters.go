@@ -1,31 +1,97 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/nrz-incubator/malygos/pkg/errors"
 )
 
+const (
+	defaultWaitTimeout = 2 * time.Minute
+	maxWaitTimeout     = 10 * time.Minute
+)
+
 func (api *ApiImpl) CreateCluster(c echo.Context) error {
 	logger := api.logger
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "create", "cluster") {
-		return c.JSON(http.StatusForbidden, nil)
-	}
+	event := api.auditEvent(c, "create", "cluster", "", "")
+	defer api.recordAudit(c, event)
 
 	cluster := &Cluster{}
 	if err := c.Bind(cluster); err != nil {
 		logger.Error(err, "failed to bind request body on create cluster")
+		event.Error = err.Error()
 		return c.JSON(http.StatusBadRequest, nil)
 	}
 
+	event.Region = cluster.Region
+	if !api.isAllowed(c, "create", "cluster", cluster.Region, "") {
+		return c.JSON(http.StatusForbidden, nil)
+	}
+
 	if err := cluster.ValidateInputs(); err != nil {
+		event.Error = err.Error()
+		return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+	}
+
+	if cluster.Team != nil && !api.canAssignTeam(c, cluster.Region, "", *cluster.Team) {
+		return c.JSON(http.StatusForbidden, Error{Error: fmt.Sprintf("caller is not a member of team %s", *cluster.Team)})
+	}
+
+	cluster.Owner = &GetUserInfo(c).Username
+
+	registrar, err := api.manager.GetClusterRegistrar().Get(cluster.Region)
+	if err != nil {
+		logger.Error(err, "failed to get cluster registrar")
+		event.Error = err.Error()
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	if registrar == nil {
+		return c.JSON(http.StatusNotFound, Error{Error: fmt.Errorf("region %s not found", cluster.Region).Error()})
+	}
+
+	capabilities, err := api.manager.GetClusterCapabilities(cluster.Region)
+	if err != nil {
+		logger.Error(err, "failed to get cluster capabilities", "region", cluster.Region)
+		event.Error = err.Error()
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	policy, err := registrar.GetControlPlanePolicy()
+	if err != nil {
+		logger.Error(err, "failed to get control plane policy", "region", cluster.Region)
+		event.Error = err.Error()
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	// the policy only defaults the options the provider of the region supports, the ones it would ignore
+	// are then rejected from the resolved cluster
+	controlPlane, err := policy.Apply(cluster.ControlPlane, capabilities)
+	if err != nil {
+		event.Error = err.Error()
+		if errors.IsInvalidArgument(err) {
+			return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+		}
+
+		logger.Error(err, "failed to apply control plane policy", "region", cluster.Region)
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+	cluster.ControlPlane = controlPlane
+
+	if err := capabilities.CheckCluster(cluster); err != nil {
+		event.Error = err.Error()
 		return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
 	}
 
 	clusterManager, err := api.manager.GetClusterManager(cluster.Region)
 	if err != nil {
+		event.Error = err.Error()
 		if errors.IsNotFound(err) {
 			return c.JSON(http.StatusNotFound, Error{Error: fmt.Errorf("region %s not found", cluster.Region).Error()})
 		}
@@ -34,45 +100,149 @@ func (api *ApiImpl) CreateCluster(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, nil)
 	}
 
+	warnings, err := api.checkQuotas(cluster, clusterManager)
+	if len(warnings) > 0 {
+		logger.Info("quota usages are incomplete", "warnings", warnings)
+	}
+
+	if err != nil {
+		event.Error = err.Error()
+		if errors.IsQuotaExceeded(err) {
+			return c.JSON(http.StatusForbidden, Error{Error: err.Error()})
+		}
+
+		logger.Error(err, "failed to check quotas")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	operation := api.startOperation(c, event, Create, cluster.Region, nil, nil)
+
 	cluster, err = clusterManager.Create(cluster)
 	if err != nil {
+		api.submitOperation(operation, nil, err)
+		event.Error = err.Error()
+		if errors.IsConflict(err) {
+			return c.JSON(http.StatusConflict, Error{Error: err.Error()})
+		}
+
 		logger.Error(err, "failed to create cluster")
 		return c.JSON(http.StatusInternalServerError, nil)
 	}
 
-	logger.WithValues("region", cluster.Region, "name", cluster.Name).Info("cluster created")
-	return c.JSON(http.StatusCreated, cluster)
+	api.submitOperation(operation, cluster.Id, nil)
+	if cluster.Id != nil {
+		event.ID = *cluster.Id
+	}
+	logger.WithValues("region", cluster.Region, "name", cluster.Name, "operation", operation.Id).Info("cluster creation started")
+	return acceptOperation(c, operation)
 }
 
-func (api *ApiImpl) DeleteCluster(c echo.Context, region string, id string) error {
+func (api *ApiImpl) DeleteCluster(c echo.Context, region string, id string, params DeleteClusterParams) error {
 	logger := api.logger.WithValues("region", region, "id", id)
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "delete", "cluster") {
+	event := api.auditEvent(c, "delete", "cluster", region, id)
+	defer api.recordAudit(c, event)
+
+	if !api.isAllowed(c, "delete", "cluster", region, id) {
 		return c.JSON(http.StatusForbidden, nil)
 	}
 
+	timeout, err := parseWaitTimeout(params.Timeout)
+	if err != nil {
+		event.Error = err.Error()
+		return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+	}
+
 	clusterManager, err := api.manager.GetClusterManager(region)
 	if err != nil {
+		event.Error = err.Error()
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, Error{Error: fmt.Errorf("region %s not found", region).Error()})
+		}
+
 		logger.Error(err, "failed to get cluster manager")
 		return c.JSON(http.StatusInternalServerError, nil)
 	}
 
-	if err = clusterManager.Delete(id); err != nil {
+	cluster, err := clusterManager.Get(id)
+	if err != nil {
+		event.Error = err.Error()
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, nil)
+		}
+
+		logger.Error(err, "failed to get cluster")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	if !api.canAccessCluster(c, cluster) {
+		return c.JSON(http.StatusNotFound, nil)
+	}
+
+	operation := api.startOperation(c, event, Delete, region, &id, nil)
+
+	err = clusterManager.Delete(id)
+	api.submitOperation(operation, &id, err)
+	if err != nil {
+		event.Error = err.Error()
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, nil)
+		}
+
 		logger.Error(err, "failed to delete cluster")
 		return c.JSON(http.StatusInternalServerError, nil)
 	}
 
+	// the subscriptions and the other operations of the cluster are only removed once its deletion is
+	// submitted, a failure leaves them to the cluster
+	if err := api.manager.GetCatalog().DeleteClusterSubscriptions(region, id); err != nil {
+		logger.Error(err, "failed to delete cluster subscriptions")
+	}
+	api.deleteClusterOperations(region, id, operation.Id)
+
+	logger.Info("cluster deletion started", "operation", operation.Id)
+	if params.Wait == nil || !*params.Wait {
+		return acceptOperation(c, operation)
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), timeout)
+	defer cancel()
+	if err := clusterManager.WaitForDeletion(ctx, id); err != nil {
+		// the operation keeps being tracked, the caller can follow it from there
+		logger.Info("cluster not removed before the timeout", "timeout", timeout)
+		return acceptOperation(c, operation)
+	}
+
+	operation = api.finishOperation(operation)
 	logger.Info("cluster deleted")
-	return c.JSON(http.StatusNoContent, nil)
+	return c.JSON(http.StatusOK, operation)
+}
+
+// parseWaitTimeout reads the duration to wait for a cluster removal
+func parseWaitTimeout(timeout *string) (time.Duration, error) {
+	if timeout == nil || *timeout == "" {
+		return defaultWaitTimeout, nil
+	}
+
+	d, err := time.ParseDuration(*timeout)
+	if err != nil || d <= 0 || d > maxWaitTimeout {
+		return 0, errors.NewInvalidArgumentError(fmt.Sprintf("timeout must be a positive duration of at most %s", maxWaitTimeout))
+	}
+
+	return d, nil
 }
 
 func (api *ApiImpl) GetCluster(c echo.Context, region string, id string) error {
 	logger := api.logger.WithValues("region", region, "id", id)
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "get", "cluster") {
+	if !api.isAllowed(c, "get", "cluster", region, id) {
 		return c.JSON(http.StatusForbidden, nil)
 	}
 
 	clusterManager, err := api.manager.GetClusterManager(region)
 	if err != nil {
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, Error{Error: fmt.Errorf("region %s not found", region).Error()})
+		}
+
 		logger.Error(err, "failed to get cluster manager")
 		return c.JSON(http.StatusInternalServerError, nil)
 	}
@@ -87,73 +257,326 @@ func (api *ApiImpl) GetCluster(c echo.Context, region string, id string) error {
 		return c.JSON(http.StatusInternalServerError, nil)
 	}
 
+	if !api.canAccessCluster(c, cluster) {
+		return c.JSON(http.StatusNotFound, nil)
+	}
+
 	return c.JSON(http.StatusOK, cluster)
 }
 
-func (api *ApiImpl) ListClusters(c echo.Context) error {
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "list", "cluster") {
+func (api *ApiImpl) GetClusterKubeconfig(c echo.Context, region string, id string) error {
+	logger := api.logger.WithValues("region", region, "id", id)
+	// handing out admin credentials is sensitive so it is audited even though it doesn't mutate anything
+	event := api.auditEvent(c, "kubeconfig", "cluster", region, id)
+	defer api.recordAudit(c, event)
+
+	if !api.isAllowed(c, "kubeconfig", "cluster", region, id) {
 		return c.JSON(http.StatusForbidden, nil)
 	}
 
-	registars, err := api.manager.GetClusterRegistrar().List()
+	clusterManager, err := api.manager.GetClusterManager(region)
 	if err != nil {
-		api.logger.Error(err, "failed to list management clusters")
-		return c.JSON(http.StatusInternalServerError, nil)
-	}
+		event.Error = err.Error()
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, Error{Error: fmt.Errorf("region %s not found", region).Error()})
+		}
 
-	resp := ListClustersResponse{
-		JSON200: &struct {
-			Clusters []Cluster "json:\"clusters\""
-			Warnings *[]string "json:\"warnings,omitempty\""
-		}{
-			Clusters: []Cluster{},
-			Warnings: nil,
-		},
+		logger.Error(err, "failed to get cluster manager")
+		return c.JSON(http.StatusInternalServerError, nil)
 	}
 
-	for _, registrar := range registars {
-		kubeClient, err := registrar.CreateClient()
-		if err != nil {
-			return c.JSON(http.StatusInternalServerError, err)
+	cluster, err := clusterManager.Get(id)
+	if err != nil {
+		event.Error = err.Error()
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, nil)
 		}
 
-		dynKubeClient, err := registrar.CreateDynamicClient()
-		if err != nil {
-			return c.JSON(http.StatusInternalServerError, err)
-		}
+		logger.Error(err, "failed to get cluster")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
 
-		clusterManager := api.manager.InstanciateClusterManager(api.logger, kubeClient, dynKubeClient)
-		clusters, err := clusterManager.List()
-		if err != nil {
-			api.logger.Error(err, "failed to list clusters")
-			*resp.JSON200.Warnings = append(*resp.JSON200.Warnings, err.Error())
+	if !api.canAccessCluster(c, cluster) {
+		return c.JSON(http.StatusNotFound, nil)
+	}
+
+	kubeconfig, err := clusterManager.GetKubeconfig(id)
+	if err != nil {
+		event.Error = err.Error()
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, nil)
 		}
 
-		for _, cluster := range clusters {
-			resp.JSON200.Clusters = append(resp.JSON200.Clusters, *cluster)
+		if errors.IsNotReady(err) {
+			return c.JSON(http.StatusConflict, Error{Error: err.Error()})
 		}
 
+		logger.Error(err, "failed to get cluster kubeconfig")
+		return c.JSON(http.StatusInternalServerError, nil)
 	}
 
-	return c.JSON(200, resp.JSON200)
+	return c.JSON(http.StatusOK, ClusterKubeconfig{Kubeconfig: kubeconfig})
 }
 
 func (api *ApiImpl) ListClusterSubscriptions(c echo.Context, region string, clusterId string) error {
-	if !api.manager.GetRBAC().IsAllowed("TODO username", "list", "cluster_subscription") {
+	if !api.isAllowed(c, "list", "cluster_subscription", region, clusterId) {
 		return c.JSON(http.StatusForbidden, nil)
 	}
 
 	clusterManager, err := api.manager.GetClusterManager(region)
 	if err != nil {
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, Error{Error: fmt.Errorf("region %s not found", region).Error()})
+		}
+
 		api.logger.Error(err, "failed to get cluster manager")
 		return c.JSON(http.StatusInternalServerError, nil)
 	}
 
+	cluster, err := clusterManager.Get(clusterId)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, nil)
+		}
+
+		api.logger.Error(err, "failed to get cluster")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	if !api.canAccessCluster(c, cluster) {
+		return c.JSON(http.StatusNotFound, nil)
+	}
+
 	subscriptions, err := clusterManager.ListSubscriptions(clusterId)
 	if err != nil {
+		if errors.IsNotImplemented(err) {
+			return c.JSON(http.StatusNotImplemented, Error{Error: err.Error()})
+		}
+
 		api.logger.Error(err, "failed to list cluster subscriptions")
 		return c.JSON(http.StatusInternalServerError, nil)
 	}
 
 	return c.JSON(http.StatusOK, subscriptions)
 }
+
+func (api *ApiImpl) UpdateCluster(c echo.Context, region string, id string) error {
+	logger := api.logger.WithValues("region", region, "id", id)
+	event := api.auditEvent(c, "update", "cluster", region, id)
+	defer api.recordAudit(c, event)
+
+	if !api.isAllowed(c, "update", "cluster", region, id) {
+		return c.JSON(http.StatusForbidden, nil)
+	}
+
+	update := &ClusterUpdate{}
+	if err := c.Bind(update); err != nil {
+		logger.Error(err, "failed to bind request body on update cluster")
+		event.Error = err.Error()
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+
+	clusterManager, err := api.manager.GetClusterManager(region)
+	if err != nil {
+		event.Error = err.Error()
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, Error{Error: fmt.Errorf("region %s not found", region).Error()})
+		}
+
+		logger.Error(err, "failed to get cluster manager")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	cluster, err := clusterManager.Get(id)
+	if err != nil {
+		event.Error = err.Error()
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, nil)
+		}
+
+		logger.Error(err, "failed to get cluster")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	if !api.canAccessCluster(c, cluster) {
+		return c.JSON(http.StatusNotFound, nil)
+	}
+
+	capabilities, err := api.manager.GetClusterCapabilities(region)
+	if err != nil {
+		logger.Error(err, "failed to get cluster capabilities")
+		event.Error = err.Error()
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	if err := capabilities.CheckUpdate(update); err != nil {
+		event.Error = err.Error()
+		return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+	}
+
+	event.Details = map[string]string{}
+	if update.Version != nil {
+		event.Details["from_version"] = cluster.Version
+		event.Details["to_version"] = *update.Version
+		if err := ValidateUpgrade(cluster.Version, *update.Version); err != nil {
+			event.Error = err.Error()
+			return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+		}
+	}
+
+	if update.Addons != nil {
+		if err := update.Addons.Validate(); err != nil {
+			event.Error = err.Error()
+			return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+		}
+
+		for name, enabled := range update.Addons.toggles() {
+			event.Details["addon_"+name] = strconv.FormatBool(enabled)
+		}
+	}
+
+	operation := api.startOperation(c, event, Update, region, &id, update.Version)
+
+	_, err = clusterManager.Update(id, update)
+	api.submitOperation(operation, &id, err)
+	if err != nil {
+		event.Error = err.Error()
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, nil)
+		}
+
+		if errors.IsNotReady(err) || errors.IsModified(err) {
+			return c.JSON(http.StatusConflict, Error{Error: err.Error()})
+		}
+
+		logger.Error(err, "failed to update cluster")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	logger.Info("cluster update started", "operation", operation.Id)
+	return acceptOperation(c, operation)
+}
+
+func (api *ApiImpl) TransferClusterOwnership(c echo.Context, region string, id string) error {
+	logger := api.logger.WithValues("region", region, "id", id)
+	event := api.auditEvent(c, "transfer", "cluster", region, id)
+	defer api.recordAudit(c, event)
+
+	if !api.isAllowed(c, "transfer", "cluster", region, id) {
+		return c.JSON(http.StatusForbidden, nil)
+	}
+
+	ownership := &ClusterOwnership{}
+	if err := c.Bind(ownership); err != nil {
+		logger.Error(err, "failed to bind request body on transfer cluster ownership")
+		event.Error = err.Error()
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+
+	if ownership.Owner != nil && *ownership.Owner == "" {
+		event.Error = "owner field must be non empty"
+		return c.JSON(http.StatusBadRequest, Error{Error: event.Error})
+	}
+
+	if ownership.Team != nil {
+		if err := ValidateTeam(*ownership.Team); err != nil {
+			event.Error = err.Error()
+			return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+		}
+	}
+
+	clusterManager, err := api.manager.GetClusterManager(region)
+	if err != nil {
+		event.Error = err.Error()
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, Error{Error: fmt.Errorf("region %s not found", region).Error()})
+		}
+
+		logger.Error(err, "failed to get cluster manager")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	cluster, err := clusterManager.Get(id)
+	if err != nil {
+		event.Error = err.Error()
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, nil)
+		}
+
+		logger.Error(err, "failed to get cluster")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	if !api.canAccessCluster(c, cluster) {
+		return c.JSON(http.StatusNotFound, nil)
+	}
+
+	owner := ""
+	if cluster.Owner != nil {
+		owner = *cluster.Owner
+	}
+	if ownership.Owner != nil {
+		owner = *ownership.Owner
+	}
+
+	team := ""
+	if cluster.Team != nil {
+		team = *cluster.Team
+	}
+	if ownership.Team != nil {
+		team = *ownership.Team
+		if team != "" && !api.canAssignTeam(c, region, id, team) {
+			return c.JSON(http.StatusForbidden, Error{Error: fmt.Sprintf("caller is not a member of team %s", team)})
+		}
+	}
+
+	event.Details = map[string]string{"owner": owner, "team": team}
+	if err := clusterManager.SetOwnership(id, owner, team); err != nil {
+		event.Error = err.Error()
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, nil)
+		}
+
+		if errors.IsModified(err) {
+			return c.JSON(http.StatusConflict, Error{Error: err.Error()})
+		}
+
+		logger.Error(err, "failed to transfer cluster ownership")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	cluster.Owner = &owner
+	cluster.Team = nil
+	if team != "" {
+		cluster.Team = &team
+	}
+
+	logger.WithValues("owner", owner, "team", team).Info("cluster ownership transferred")
+	return c.JSON(http.StatusOK, cluster)
+}
+
+// canAccessCluster reports whether the caller owns the cluster, belongs to its team, or is
+// allowed the admin action on clusters, which grants access to every cluster. Other clusters are
+// reported as missing to not disclose them.
+func (api *ApiImpl) canAccessCluster(c echo.Context, cluster *Cluster) bool {
+	user := GetUserInfo(c)
+	if cluster.Owner != nil && *cluster.Owner == user.Username {
+		return true
+	}
+
+	if cluster.Team != nil && slices.Contains(user.Groups, *cluster.Team) {
+		return true
+	}
+
+	id := ""
+	if cluster.Id != nil {
+		id = *cluster.Id
+	}
+
+	return api.isAllowed(c, "admin", "cluster", cluster.Region, id)
+}
+
+// canAssignTeam reports whether the caller can give a cluster to team, admins can use any team.
+func (api *ApiImpl) canAssignTeam(c echo.Context, region, id, team string) bool {
+	return team == "" || slices.Contains(GetUserInfo(c).Groups, team) || api.isAllowed(c, "admin", "cluster", region, id)
+}
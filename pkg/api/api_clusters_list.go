@@ -0,0 +1,218 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+)
+
+const (
+	// registrarListTimeout bounds each request to a registrar, so that an unreachable region only
+	// shows up as a warning
+	registrarListTimeout = 10 * time.Second
+	// maxListWorkers bounds the number of registrars listed at once
+	maxListWorkers = 8
+)
+
+// regionPage is a batch of clusters listed from a region
+type regionPage struct {
+	// from is the continue token the batch was listed from
+	from     string
+	clusters []*Cluster
+	next     string
+	err      error
+}
+
+// regionLister lists the clusters of a region from a continue token, up to limit when not 0
+type regionLister func(region int, from string, limit int64) *regionPage
+
+func (api *ApiImpl) ListClusters(c echo.Context, params ListClustersParams) error {
+	if !api.isAllowed(c, "list", "cluster", "", "") {
+		return c.JSON(http.StatusForbidden, nil)
+	}
+
+	options := ClusterListOptions{}
+	if params.LabelSelector != nil {
+		if _, err := UserLabelSelector(*params.LabelSelector); err != nil {
+			return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+		}
+		options.LabelSelector = *params.LabelSelector
+	}
+
+	limit, err := pageLimit(params.Limit)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+	}
+
+	cursor, err := decodeListCursor(params.Continue)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+	}
+
+	registrars, err := api.manager.GetClusterRegistrar().List()
+	if err != nil {
+		api.logger.Error(err, "failed to list management clusters")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	// regions are paged one after the other, so that a page resumes a single region
+	slices.SortFunc(registrars, func(a, b *ClusterRegistrar) int {
+		return strings.Compare(a.Region, b.Region)
+	})
+
+	regions := []string{}
+	candidates := []*ClusterRegistrar{}
+	for _, registrar := range registrars {
+		if cursor != nil && (registrar.Region < cursor.Region || (registrar.Region == cursor.Region && cursor.Continue == "")) {
+			continue
+		}
+
+		if !api.isAllowed(c, "list", "cluster", registrar.Region, "") {
+			continue
+		}
+
+		regions = append(regions, registrar.Region)
+		candidates = append(candidates, registrar)
+	}
+
+	managers := make([]ClusterManager, len(candidates))
+	list := func(region int, from string, limit int64) *regionPage {
+		if managers[region] == nil {
+			client, err := candidates[region].CreateDynamicClientWithTimeout(registrarListTimeout)
+			if err != nil {
+				return &regionPage{from: from, err: err}
+			}
+			if managers[region], err = api.manager.InstanciateClusterManager(api.logger, candidates[region], client); err != nil {
+				return &regionPage{from: from, err: err}
+			}
+		}
+
+		regionOptions := options
+		regionOptions.Limit = limit
+		regionOptions.Continue = from
+		clusters, next, err := managers[region].List(regionOptions)
+		return &regionPage{from: from, clusters: clusters, next: next, err: err}
+	}
+
+	// the first batch of every region is listed concurrently, each worker only touches its region
+	pages := make([]*regionPage, len(candidates))
+	workers := make(chan struct{}, maxListWorkers)
+	wg := sync.WaitGroup{}
+	for i, registrar := range candidates {
+		from := ""
+		if cursor != nil && registrar.Region == cursor.Region {
+			from = cursor.Continue
+		}
+
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(i int, from string) {
+			defer wg.Done()
+			defer func() { <-workers }()
+			pages[i] = list(i, from, limit)
+		}(i, from)
+	}
+	wg.Wait()
+
+	accessible := func(cluster *Cluster) bool {
+		return api.isAllowed(c, "list", "cluster", cluster.Region, *cluster.Id) && api.canAccessCluster(c, cluster)
+	}
+
+	clusters, next, warnings, err := pageClusters(regions, pages, limit, accessible, list)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+	}
+
+	resp := ListClustersResponse{
+		JSON200: &struct {
+			Clusters []Cluster "json:\"clusters\""
+			Continue *string   "json:\"continue,omitempty\""
+			Warnings *[]string "json:\"warnings,omitempty\""
+		}{
+			Clusters: clusters,
+		},
+	}
+
+	if next != nil {
+		resp.JSON200.Continue = next.encode()
+	}
+
+	if len(warnings) > 0 {
+		api.logger.Info("clusters of some regions could not be listed", "warnings", warnings)
+		resp.JSON200.Warnings = &warnings
+	}
+
+	return c.JSON(http.StatusOK, resp.JSON200)
+}
+
+// pageClusters fills a page with the accessible clusters of the regions in order, starting from their
+// first batch. Regions failing to list are reported as warnings, except for an invalid continue token.
+func pageClusters(regions []string, pages []*regionPage, limit int64, accessible func(*Cluster) bool,
+	list regionLister) ([]Cluster, *listCursor, []string, error) {
+	clusters := []Cluster{}
+	warnings := []string{}
+	// remaining is the room left in the page, 0 when it is not limited
+	remaining := func() int64 {
+		if limit == 0 {
+			return 0
+		}
+		return limit - int64(len(clusters))
+	}
+	full := func() bool {
+		return limit > 0 && remaining() == 0
+	}
+
+	lastRegion := ""
+	for i, region := range regions {
+		// the page is only cut when another region follows
+		if full() {
+			return clusters, &listCursor{Region: lastRegion}, warnings, nil
+		}
+
+		page := pages[i]
+		for page.err == nil {
+			batch := []Cluster{}
+			for _, cluster := range page.clusters {
+				if accessible(cluster) {
+					batch = append(batch, *cluster)
+				}
+			}
+
+			// the continue token can't resume in the middle of a batch, what fits is listed again
+			if limit > 0 && int64(len(batch)) > remaining() {
+				page = list(i, page.from, remaining())
+				continue
+			}
+
+			clusters = append(clusters, batch...)
+			if page.next == "" || full() {
+				break
+			}
+
+			// inaccessible clusters are skipped, the next batches fill the page
+			page = list(i, page.next, remaining())
+		}
+
+		lastRegion = region
+		if page.err != nil {
+			if errors.IsInvalidArgument(page.err) {
+				return nil, nil, nil, page.err
+			}
+
+			warnings = append(warnings, fmt.Sprintf("region %s: %v", region, page.err))
+			continue
+		}
+
+		if page.next != "" {
+			return clusters, &listCursor{Region: region, Continue: page.next}, warnings, nil
+		}
+	}
+
+	return clusters, nil, warnings, nil
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+// listAll pages through the regions like ListClusters, with continue tokens being offsets
+func listAll(t *testing.T, regions map[string][]string, failing string, limit int64) ([]string, []string, int) {
+	t.Helper()
+
+	all := []string{"eu", "fr", "us"}
+	lister := func(names []string) regionLister {
+		return func(region int, from string, limit int64) *regionPage {
+			if names[region] == failing {
+				return &regionPage{from: from, err: fmt.Errorf("unreachable")}
+			}
+
+			if from == "invalid" {
+				return &regionPage{from: from, err: errors.NewInvalidArgumentError("continue token is expired or invalid")}
+			}
+
+			clusters := regions[names[region]]
+			start, _ := strconv.Atoi(from)
+			end := len(clusters)
+			if limit > 0 && start+int(limit) < end {
+				end = start + int(limit)
+			}
+
+			page := &regionPage{from: from}
+			for _, id := range clusters[start:end] {
+				page.clusters = append(page.clusters, &Cluster{Id: ptr.To(id), Region: names[region]})
+			}
+			if end < len(clusters) {
+				page.next = strconv.Itoa(end)
+			}
+			return page
+		}
+	}
+
+	accessible := func(cluster *Cluster) bool {
+		return *cluster.Id != "eu-2"
+	}
+
+	ids := []string{}
+	warnings := []string{}
+	pages := 0
+	var cursor *listCursor
+	for {
+		names := []string{}
+		for _, region := range all {
+			if cursor == nil || region > cursor.Region || (region == cursor.Region && cursor.Continue != "") {
+				names = append(names, region)
+			}
+		}
+
+		list := lister(names)
+		first := make([]*regionPage, len(names))
+		for i, region := range names {
+			from := ""
+			if cursor != nil && region == cursor.Region {
+				from = cursor.Continue
+			}
+			first[i] = list(i, from, limit)
+		}
+
+		clusters, next, pageWarnings, err := pageClusters(names, first, limit, accessible, list)
+		require.NoError(t, err)
+		pages++
+		if limit > 0 {
+			assert.LessOrEqual(t, int64(len(clusters)), limit)
+		}
+
+		for _, cluster := range clusters {
+			ids = append(ids, *cluster.Id)
+		}
+		warnings = append(warnings, pageWarnings...)
+
+		if next == nil {
+			return ids, warnings, pages
+		}
+
+		cursor, err = decodeListCursor(next.encode())
+		require.NoError(t, err)
+	}
+}
+
+func Test_PageClusters(t *testing.T) {
+	regions := map[string][]string{
+		"eu": {"eu-1", "eu-2", "eu-3", "eu-4", "eu-5"},
+		"fr": {"fr-1"},
+		"us": {"us-1", "us-2", "us-3"},
+	}
+	expected := []string{"eu-1", "eu-3", "eu-4", "eu-5", "fr-1", "us-1", "us-2", "us-3"}
+
+	ids, warnings, pages := listAll(t, regions, "", 0)
+	assert.Equal(t, expected, ids)
+	assert.Empty(t, warnings)
+	assert.Equal(t, 1, pages)
+
+	for _, limit := range []int64{1, 2, 3, 4, 8, 500} {
+		ids, _, _ = listAll(t, regions, "", limit)
+		assert.Equal(t, expected, ids, "limit %d", limit)
+	}
+
+	// an unreachable region is reported while the others are listed
+	ids, warnings, _ = listAll(t, regions, "fr", 3)
+	assert.Equal(t, []string{"eu-1", "eu-3", "eu-4", "eu-5", "us-1", "us-2", "us-3"}, ids)
+	assert.Equal(t, []string{"region fr: unreachable"}, warnings)
+
+	_, _, _, err := pageClusters([]string{"eu"}, []*regionPage{{from: "invalid", err: errors.NewInvalidArgumentError("expired")}}, 2,
+		func(*Cluster) bool { return true }, nil)
+	assert.True(t, errors.IsInvalidArgument(err))
+}
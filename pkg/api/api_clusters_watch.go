@@ -0,0 +1,135 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+)
+
+const (
+	LastEventIDHeader = "Last-Event-ID"
+	// keepaliveInterval keeps proxies from closing idle streams
+	keepaliveInterval = 30 * time.Second
+)
+
+func (api *ApiImpl) WatchClusters(c echo.Context, params WatchClustersParams) error {
+	if !api.isAllowed(c, "list", "cluster", "", "") {
+		return c.JSON(http.StatusForbidden, nil)
+	}
+
+	// browsers resend the last event id on their own when reconnecting
+	rawCursor := c.Request().Header.Get(LastEventIDHeader)
+	if params.ResourceVersion != nil {
+		rawCursor = *params.ResourceVersion
+	}
+
+	cursor, err := ParseWatchCursor(rawCursor)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+	}
+
+	regions := []string{}
+	if params.Region != nil && *params.Region != "" {
+		regions = append(regions, *params.Region)
+	}
+
+	ctx := c.Request().Context()
+	events, err := api.manager.GetClusterWatcher().Watch(ctx, regions, cursor)
+	if err != nil {
+		if errors.IsInvalidArgument(err) {
+			return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+		}
+
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, Error{Error: err.Error()})
+		}
+
+		api.logger.Error(err, "failed to watch clusters")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	response := c.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	response.Header().Set(echo.HeaderConnection, "keep-alive")
+	response.Header().Set("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	keepalive := time.NewTicker(keepaliveInterval)
+	defer keepalive.Stop()
+
+	// access is checked per region and cluster like when listing
+	allowedRegions := map[string]bool{}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-keepalive.C:
+			if _, err := fmt.Fprint(response, ": keepalive\n\n"); err != nil {
+				return nil
+			}
+			response.Flush()
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			if event.ResourceVersion != "" {
+				cursor[event.Region] = event.ResourceVersion
+			}
+
+			allowed, checked := allowedRegions[event.Region]
+			if !checked {
+				allowed = api.isAllowed(c, "list", "cluster", event.Region, "")
+				allowedRegions[event.Region] = allowed
+			}
+
+			if !allowed {
+				continue
+			}
+
+			// RESET and WARNING events are about the whole region
+			if event.Cluster != nil {
+				if event.Cluster.Id == nil || (params.ClusterId != nil && *event.Cluster.Id != *params.ClusterId) {
+					continue
+				}
+
+				if !api.isAllowed(c, "list", "cluster", event.Region, *event.Cluster.Id) || !api.canAccessCluster(c, event.Cluster) {
+					continue
+				}
+			}
+
+			if err := writeClusterEvent(response, event, FormatWatchCursor(cursor)); err != nil {
+				api.logger.Error(err, "failed to write cluster event")
+				return nil
+			}
+			response.Flush()
+		}
+	}
+}
+
+// regionEvent is the data of the events about a whole region
+type regionEvent struct {
+	Region  string `json:"region"`
+	Message string `json:"message,omitempty"`
+}
+
+func writeClusterEvent(response *echo.Response, event ClusterEvent, cursor string) error {
+	var payload interface{} = event.Cluster
+	if event.Cluster == nil {
+		payload = regionEvent{Region: event.Region, Message: event.Message}
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(response, "id: %s\nevent: %s\ndata: %s\n\n", cursor, event.Type, data)
+	return err
+}
@@ -0,0 +1,166 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/nrz-incubator/malygos/pkg/audit"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+)
+
+const (
+	submitAttempts   = 3
+	submitRetryDelay = 100 * time.Millisecond
+)
+
+func (api *ApiImpl) ListOperations(c echo.Context, params ListOperationsParams) error {
+	if !api.isAllowed(c, "list", "operation", "", "") {
+		return c.JSON(http.StatusForbidden, nil)
+	}
+
+	all, err := api.manager.GetOperationManager().List("", params.State)
+	if err != nil {
+		api.logger.Error(err, "failed to list operations")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	// the caller sees its own operations and the ones of the regions it administers
+	username := GetUserInfo(c).Username
+	operations := []Operation{}
+	for _, operation := range all {
+		if operation.Owner == username || api.isAllowed(c, "admin", "operation", operation.Region, operation.Id) {
+			operations = append(operations, operation)
+		}
+	}
+
+	resp := ListOperationsResponse{
+		JSON200: &struct {
+			Operations []Operation `json:"operations"`
+		}{
+			Operations: operations,
+		},
+	}
+
+	return c.JSON(http.StatusOK, resp.JSON200)
+}
+
+func (api *ApiImpl) GetOperation(c echo.Context, id string) error {
+	logger := api.logger.WithValues("id", id)
+	operation, err := api.manager.GetOperationManager().Get(id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, nil)
+		}
+
+		logger.Error(err, "failed to get operation")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	// the region of an operation is only known once read, operations the caller can't read or which
+	// belong to other users are reported as missing to not disclose them
+	if !api.isAllowed(c, "get", "operation", operation.Region, id) {
+		return c.JSON(http.StatusNotFound, nil)
+	}
+
+	if operation.Owner != GetUserInfo(c).Username && !api.isAllowed(c, "admin", "operation", operation.Region, id) {
+		return c.JSON(http.StatusNotFound, nil)
+	}
+
+	return c.JSON(http.StatusOK, operation)
+}
+
+// startOperation records a running operation of the caller before its submission to the region. A
+// failure to record it doesn't prevent the submission, the operation is then returned untracked,
+// without an id. The target version of an update is recorded up front so that the tracker never
+// observes the update without it.
+func (api *ApiImpl) startOperation(c echo.Context, event *audit.Event, operationType OperationType, region string,
+	clusterID *string, targetVersion *string) *Operation {
+	operation := NewOperation(operationType, region, GetUserInfo(c).Username)
+	operation.ClusterId = clusterID
+	operation.TargetVersion = targetVersion
+
+	recorded, err := api.manager.GetOperationManager().Create(operation)
+	if err != nil {
+		api.logger.Error(err, "failed to record operation, it won't be tracked", "type", operationType, "region", region)
+		operation.Id = ""
+		return operation
+	}
+
+	if event.Details == nil {
+		event.Details = map[string]string{}
+	}
+	event.Details["operation"] = recorded.Id
+	return recorded
+}
+
+// submitOperation records the outcome of the submission, the operation is then tracked in the background.
+// The update is retried as the tracker expires a submitted operation missing its cluster.
+func (api *ApiImpl) submitOperation(operation *Operation, clusterID *string, err error) {
+	now := time.Now().UTC()
+	if err != nil {
+		operation.Fail(now, err)
+	} else {
+		operation.ClusterId = clusterID
+		operation.AddMessage(now, fmt.Sprintf("%s submitted to region %s", operation.Type, operation.Region))
+	}
+
+	if operation.Id == "" {
+		return
+	}
+
+	for attempt := 1; ; attempt++ {
+		err = api.manager.GetOperationManager().Update(operation)
+		if err == nil || attempt == submitAttempts {
+			break
+		}
+		time.Sleep(time.Duration(attempt) * submitRetryDelay)
+	}
+
+	if err != nil {
+		api.logger.Error(err, "failed to record operation submission", "operation", operation.Id)
+	}
+}
+
+// finishOperation marks a deletion succeeded
+func (api *ApiImpl) finishOperation(operation *Operation) *Operation {
+	operation.Succeed(time.Now().UTC(), nil)
+	if operation.Id == "" {
+		return operation
+	}
+
+	if err := api.manager.GetOperationManager().Update(operation); err != nil {
+		api.logger.Error(err, "failed to record operation completion", "operation", operation.Id)
+	}
+
+	return operation
+}
+
+// deleteClusterOperations removes the operations of a deleted cluster, but its deletion which is still
+// tracked
+func (api *ApiImpl) deleteClusterOperations(region string, clusterID string, deletionID string) {
+	operations, err := api.manager.GetOperationManager().List("", nil)
+	if err != nil {
+		api.logger.Error(err, "failed to list cluster operations", "region", region, "id", clusterID)
+		return
+	}
+
+	for _, operation := range operations {
+		if operation.Id == deletionID || operation.Region != region || operation.ClusterId == nil ||
+			*operation.ClusterId != clusterID {
+			continue
+		}
+
+		if err := api.manager.GetOperationManager().Delete(operation.Id); err != nil && !errors.IsNotFound(err) {
+			api.logger.Error(err, "failed to delete cluster operation", "operation", operation.Id)
+		}
+	}
+}
+
+func acceptOperation(c echo.Context, operation *Operation) error {
+	if operation.Id != "" {
+		c.Response().Header().Set(echo.HeaderLocation, "/v1/operations/"+operation.Id)
+	}
+	return c.JSON(http.StatusAccepted, operation)
+}
@@ -0,0 +1,171 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (api *ApiImpl) ListQuotas(c echo.Context) error {
+	if !api.isAllowed(c, "list", "quota", "", "") {
+		return c.JSON(http.StatusForbidden, nil)
+	}
+
+	quotas, err := api.manager.GetQuotaManager().List()
+	if err != nil {
+		api.logger.Error(err, "failed to list quotas")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	resp := ListQuotasResponse{
+		JSON200: &struct {
+			Quotas   []Quota   `json:"quotas"`
+			Warnings *[]string `json:"warnings,omitempty"`
+		}{
+			Quotas: []Quota{},
+		},
+	}
+
+	user := GetUserInfo(c)
+	admin := api.isAllowed(c, "admin", "cluster", "", "")
+	usages := newQuotaUsages()
+	for _, quota := range quotas {
+		// clusters without a team count against quotas named after their owner
+		if !admin && quota.Team != nil && *quota.Team != user.Username && !slices.Contains(user.Groups, *quota.Team) {
+			continue
+		}
+
+		usage, err := api.quotaUsage(&quota, usages)
+		if err != nil {
+			api.logger.Error(err, "failed to compute quota usage", "quota", quota.Name)
+			usages.warnings = append(usages.warnings, fmt.Sprintf("failed to compute usage of quota %s", quota.Name))
+		} else {
+			quota.Usage = usage.ToAPI()
+		}
+
+		resp.JSON200.Quotas = append(resp.JSON200.Quotas, quota)
+	}
+
+	if len(usages.warnings) > 0 {
+		resp.JSON200.Warnings = &usages.warnings
+	}
+
+	return c.JSON(http.StatusOK, resp.JSON200)
+}
+
+// checkQuotas returns a QuotaExceededError when creating cluster would go over one of the quotas
+// applying to its team, or its owner when it has none, and region. Concurrent creations can both
+// pass the check. The returned warnings name the regions left out of the usages.
+func (api *ApiImpl) checkQuotas(cluster *Cluster, clusterManager ClusterManager) ([]string, error) {
+	quotas, err := api.manager.GetQuotaManager().List()
+	if err != nil {
+		return nil, err
+	}
+
+	team := ""
+	if cluster.Team != nil && *cluster.Team != "" {
+		team = *cluster.Team
+	} else if cluster.Owner != nil {
+		team = *cluster.Owner
+	}
+
+	var footprint *ResourceUsage
+	usages := newQuotaUsages()
+	for _, quota := range quotas {
+		if !quota.Applies(team, cluster.Region) {
+			continue
+		}
+
+		if footprint == nil {
+			if footprint, err = clusterManager.Footprint(cluster); err != nil {
+				return usages.warnings, err
+			}
+		}
+
+		usage, err := api.quotaUsage(&quota, usages)
+		if err != nil {
+			return usages.warnings, err
+		}
+
+		usage.Add(footprint)
+		if err := quota.Check(usage); err != nil {
+			return usages.warnings, err
+		}
+	}
+
+	return usages.warnings, nil
+}
+
+// quotaUsages caches the per region usages as quotas often share regions and teams. Regions which
+// can't be reached are left out of the usages rather than failing every quota spanning them.
+type quotaUsages struct {
+	usages      map[string]*ResourceUsage
+	unreachable map[string]bool
+	warnings    []string
+}
+
+func newQuotaUsages() *quotaUsages {
+	return &quotaUsages{
+		usages:      map[string]*ResourceUsage{},
+		unreachable: map[string]bool{},
+		warnings:    []string{},
+	}
+}
+
+// quotaUsage sums the usage of the clusters counted by quota.
+func (api *ApiImpl) quotaUsage(quota *Quota, usages *quotaUsages) (*ResourceUsage, error) {
+	team := ""
+	if quota.Team != nil {
+		team = *quota.Team
+	}
+
+	regions := []string{}
+	if quota.Region != nil && *quota.Region != "" {
+		regions = append(regions, *quota.Region)
+	} else {
+		registrars, err := api.manager.GetClusterRegistrar().List()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list management clusters: %v", err)
+		}
+
+		for _, registrar := range registrars {
+			regions = append(regions, registrar.Region)
+		}
+	}
+
+	total := &ResourceUsage{}
+	for _, region := range regions {
+		if usages.unreachable[region] {
+			continue
+		}
+
+		key := region + "/" + team
+		usage, ok := usages.usages[key]
+		if !ok {
+			var err error
+			usage, err = api.regionUsage(region, team)
+			if err != nil {
+				api.logger.Error(err, "failed to compute region usage", "region", region)
+				usages.unreachable[region] = true
+				usages.warnings = append(usages.warnings, fmt.Sprintf("region %s is left out of quota usages: %v", region, err))
+				continue
+			}
+			usages.usages[key] = usage
+		}
+
+		total.Add(usage)
+	}
+
+	return total, nil
+}
+
+func (api *ApiImpl) regionUsage(region, team string) (*ResourceUsage, error) {
+	clusterManager, err := api.manager.GetClusterManager(region)
+	if err != nil {
+		return nil, err
+	}
+
+	return clusterManager.Usage(team)
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+)
+
+func (api *ApiImpl) ListTokens(c echo.Context) error {
+	user := GetUserInfo(c)
+	if user.Username == AnonymousUsername {
+		return c.JSON(http.StatusUnauthorized, Error{Error: "tokens require an authenticated user"})
+	}
+
+	if !api.isAllowed(c, "list", "token", "", "") {
+		return c.JSON(http.StatusForbidden, nil)
+	}
+
+	tokens, err := api.manager.GetTokenManager().List(user.Username)
+	if err != nil {
+		api.logger.Error(err, "failed to list tokens")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	resp := ListTokensResponse{
+		JSON200: &struct {
+			Tokens []Token `json:"tokens"`
+		}{
+			Tokens: tokens,
+		},
+	}
+
+	return c.JSON(http.StatusOK, resp.JSON200)
+}
+
+func (api *ApiImpl) CreateToken(c echo.Context) error {
+	logger := api.logger
+	event := api.auditEvent(c, "create", "token", "", "")
+	defer api.recordAudit(c, event)
+
+	user := GetUserInfo(c)
+	if user.Username == AnonymousUsername {
+		return c.JSON(http.StatusUnauthorized, Error{Error: "tokens require an authenticated user"})
+	}
+
+	// a token would outlive the impersonation and give lasting access as the impersonated user
+	if GetImpersonator(c) != nil {
+		return c.JSON(http.StatusForbidden, Error{Error: "tokens can't be created while impersonating"})
+	}
+
+	request := &TokenRequest{}
+	if err := c.Bind(request); err != nil {
+		logger.Error(err, "failed to bind request body on create token")
+		event.Error = err.Error()
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+
+	if !api.isAllowed(c, "create", "token", "", "") {
+		return c.JSON(http.StatusForbidden, nil)
+	}
+
+	// service account tokens outlive their creator so they are granted separately
+	if request.ServiceAccount != nil && *request.ServiceAccount != "" {
+		event.Details = map[string]string{"service_account": *request.ServiceAccount}
+		if !api.isAllowed(c, "create", "serviceaccount_token", "", *request.ServiceAccount) {
+			return c.JSON(http.StatusForbidden, nil)
+		}
+	}
+
+	token, err := api.manager.GetTokenManager().Create(user, request)
+	if err != nil {
+		event.Error = err.Error()
+		if errors.IsInvalidArgument(err) {
+			return c.JSON(http.StatusBadRequest, Error{Error: err.Error()})
+		}
+
+		logger.Error(err, "failed to create token")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	event.ID = token.Id
+	return c.JSON(http.StatusCreated, token)
+}
+
+func (api *ApiImpl) DeleteToken(c echo.Context, tokenId string) error {
+	event := api.auditEvent(c, "delete", "token", "", tokenId)
+	defer api.recordAudit(c, event)
+
+	user := GetUserInfo(c)
+	if user.Username == AnonymousUsername {
+		return c.JSON(http.StatusUnauthorized, Error{Error: "tokens require an authenticated user"})
+	}
+
+	if !api.isAllowed(c, "delete", "token", "", tokenId) {
+		return c.JSON(http.StatusForbidden, nil)
+	}
+
+	if err := api.manager.GetTokenManager().Delete(user.Username, tokenId); err != nil {
+		event.Error = err.Error()
+		if errors.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, nil)
+		}
+
+		api.logger.Error(err, "failed to delete token")
+		return c.JSON(http.StatusInternalServerError, nil)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
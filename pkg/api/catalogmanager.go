@@ -1,7 +1,8 @@
 package api
 
 type CatalogManager interface {
-	ListComponents() ([]CatalogComponent, error)
+	// ListComponents returns the components ordered by name, along with the continue token of the next page
+	ListComponents(options PageOptions) ([]CatalogComponent, string, error)
 	AddComponent(component *CatalogComponent) error
 	DeleteComponent(componentName string) error
 	GetComponent(componentName string) (*CatalogComponent, error)
@@ -11,4 +12,6 @@ type CatalogManager interface {
 	SubscribeComponentVersion(region string, clusterId string, componentName string, componentVersion string) error
 	ListComponentVersionSubscriptions(componentName string, componentVersion string) ([]SubscribedClusters, error)
 	UnsubscribeComponentVersion(region string, clusterId string, componentName string, componentVersion string) error
+	// DeleteClusterSubscriptions removes the component subscriptions of a deleted cluster
+	DeleteClusterSubscriptions(region string, clusterId string) error
 }
@@ -1,15 +1,32 @@
 package api
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/nrz-incubator/malygos/pkg/errors"
 	"golang.org/x/mod/semver"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
+const minimumVersion = "v1.28.0"
+
 func (c *Cluster) ValidateInputs() error {
 	if c.Id != nil {
 		return errors.NewInvalidArgumentError("id field is not allowed")
 	}
 
+	if c.Owner != nil {
+		return errors.NewInvalidArgumentError("owner field is set by the server")
+	}
+
+	if c.Team != nil {
+		if err := ValidateTeam(*c.Team); err != nil {
+			return err
+		}
+	}
+
 	if c.Region == "" {
 		return errors.NewInvalidArgumentError("region field is required")
 	}
@@ -18,16 +35,87 @@ func (c *Cluster) ValidateInputs() error {
 		return errors.NewInvalidArgumentError("name field is required")
 	}
 
-	if c.Version == "" {
+	if c.Network != nil {
+		if err := c.Network.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.Addons != nil {
+		if err := c.Addons.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.Labels != nil {
+		if err := ValidateUserLabels(*c.Labels); err != nil {
+			return err
+		}
+	}
+
+	if c.Annotations != nil {
+		if err := ValidateUserAnnotations(*c.Annotations); err != nil {
+			return err
+		}
+	}
+
+	return ValidateVersion(c.Version)
+}
+
+// ValidateVersion checks that a Kubernetes version can be deployed.
+func ValidateVersion(version string) error {
+	if version == "" {
 		return errors.NewInvalidArgumentError("version field is required")
 	}
 
-	if !semver.IsValid(c.Version) {
+	if !semver.IsValid(version) {
 		return errors.NewInvalidArgumentError("version field is not a valid semver")
 	}
 
-	if semver.Compare(c.Version, "v1.28.0") < 0 {
-		return errors.NewInvalidArgumentError("version field must be >= v1.28.0")
+	if semver.Compare(version, minimumVersion) < 0 {
+		return errors.NewInvalidArgumentError(fmt.Sprintf("version field must be >= %s", minimumVersion))
+	}
+
+	return nil
+}
+
+// ValidateUpgrade checks that a cluster can go from version current to target, Kubernetes only
+// supports upgrading the control plane one minor version at a time and never downgrading it.
+func ValidateUpgrade(current string, target string) error {
+	if err := ValidateVersion(target); err != nil {
+		return err
+	}
+
+	if semver.Compare(target, current) < 0 {
+		return errors.NewInvalidArgumentError(fmt.Sprintf("version can't be downgraded from %s to %s", current, target))
+	}
+
+	if semver.Major(target) != semver.Major(current) {
+		return errors.NewInvalidArgumentError(fmt.Sprintf("version can't be upgraded across major versions from %s to %s", current, target))
+	}
+
+	if minor(target)-minor(current) > 1 {
+		return errors.NewInvalidArgumentError(fmt.Sprintf("version can't skip minor versions from %s to %s", current, target))
+	}
+
+	return nil
+}
+
+// minor returns the minor version number of a valid semver
+func minor(version string) int {
+	parts := strings.SplitN(strings.TrimPrefix(semver.MajorMinor(version), "v"), ".", 2)
+	if len(parts) < 2 {
+		return 0
+	}
+
+	n, _ := strconv.Atoi(parts[1])
+	return n
+}
+
+// ValidateTeam checks that a team can be stored as a label value, an empty team means no team.
+func ValidateTeam(team string) error {
+	if errs := validation.IsValidLabelValue(team); len(errs) > 0 {
+		return errors.NewInvalidArgumentError(fmt.Sprintf("team field is invalid: %s", strings.Join(errs, ", ")))
 	}
 
 	return nil
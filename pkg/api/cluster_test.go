@@ -0,0 +1,35 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ValidateUpgrade(t *testing.T) {
+	tests := []struct {
+		current string
+		target  string
+		valid   bool
+	}{
+		{"v1.28.0", "v1.28.0", true},
+		{"v1.28.0", "v1.28.4", true},
+		{"v1.28.4", "v1.29.0", true},
+		{"v1.28.4", "v1.30.0", false},
+		{"v1.29.0", "v1.28.9", false},
+		{"v1.29.1", "v1.29.0", false},
+		{"v1.29.0", "v2.0.0", false},
+		{"v1.29.0", "1.30.0", false},
+		{"v1.29.0", "", false},
+	}
+
+	for _, test := range tests {
+		err := ValidateUpgrade(test.current, test.target)
+		if test.valid {
+			assert.NoError(t, err, "%s -> %s", test.current, test.target)
+		} else {
+			assert.True(t, errors.IsInvalidArgument(err), "%s -> %s", test.current, test.target)
+		}
+	}
+}
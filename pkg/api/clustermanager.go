@@ -1,9 +1,32 @@
 package api
 
+import "context"
+
 type ClusterManager interface {
 	Create(cluster *Cluster) (*Cluster, error)
 	Delete(id string) error
-	List() ([]*Cluster, error)
+	// WaitForDeletion blocks until a deleted cluster and the resources generated for it are removed
+	WaitForDeletion(ctx context.Context, id string) error
+	// List returns the clusters ordered by ID, along with the continue token of the next page when limited
+	List(options ClusterListOptions) ([]*Cluster, string, error)
 	Get(id string) (*Cluster, error)
+	// GetKubeconfig returns an admin kubeconfig pointing to the public endpoint of the cluster
+	GetKubeconfig(id string) (string, error)
 	ListSubscriptions(id string) ([]*CatalogComponent, error)
+	// Update upgrades the version of a cluster and toggles its addons, unset fields are left unchanged
+	Update(id string, update *ClusterUpdate) (*Cluster, error)
+	// SetOwnership replaces the owner and team of a cluster, an empty team removes it
+	SetOwnership(id string, owner string, team string) error
+	// Usage sums the capacity used by the clusters of team, clusters without a team count against their
+	// owner as if it was their team. Every cluster is counted when team is empty
+	Usage(team string) (*ResourceUsage, error)
+	// Footprint returns the capacity a cluster will use once created
+	Footprint(cluster *Cluster) (*ResourceUsage, error)
+}
+
+// ClusterListOptions restricts the clusters returned by ClusterManager.List
+type ClusterListOptions struct {
+	PageOptions
+	// LabelSelector selects clusters on their user labels, in the Kubernetes syntax
+	LabelSelector string
 }
@@ -1,10 +1,11 @@
 package api
 
 import (
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"k8s.io/client-go/dynamic"
-	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
@@ -22,6 +23,32 @@ type ClusterRegistrar struct {
 	Region     string
 	restConfig *rest.Config
 	Kubeconfig string
+	// Annotations of the registrar object, used to configure the region
+	Annotations map[string]string
+}
+
+// GetControlPlanePolicy returns the control plane policy of the region, nil when it has none.
+func (m *ClusterRegistrar) GetControlPlanePolicy() (*ControlPlanePolicy, error) {
+	value, ok := m.Annotations[ControlPlanePolicyAnnotation]
+	if !ok || value == "" {
+		return nil, nil
+	}
+
+	policy := &ControlPlanePolicy{}
+	if err := json.Unmarshal([]byte(value), policy); err != nil {
+		return nil, fmt.Errorf("invalid control plane policy on registrar %s: %v", m.Name, err)
+	}
+
+	return policy, nil
+}
+
+// GetProvider returns the name of the provider managing the clusters of the region.
+func (m *ClusterRegistrar) GetProvider() string {
+	if provider := m.Annotations[ClusterProviderAnnotation]; provider != "" {
+		return provider
+	}
+
+	return DefaultClusterProvider
 }
 
 func (m *ClusterRegistrar) buildConfig() error {
@@ -38,34 +65,21 @@ func (m *ClusterRegistrar) buildConfig() error {
 	return nil
 }
 
-func (m *ClusterRegistrar) CreateClient() (*kubernetes.Clientset, error) {
-	if m.restConfig == nil {
-		if err := m.buildConfig(); err != nil {
-			return nil, err
-		}
-	}
-
-	client, err := kubernetes.NewForConfig(m.restConfig)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create k8s client: %v", err)
-	}
-
-	_, err = client.DiscoveryClient.ServerVersion()
-	if err != nil {
-		return nil, fmt.Errorf("failed to connect to k8s cluster: %v", err)
-	}
-
-	return client, nil
+func (m *ClusterRegistrar) CreateDynamicClient() (*dynamic.DynamicClient, error) {
+	return m.CreateDynamicClientWithTimeout(0)
 }
 
-func (m *ClusterRegistrar) CreateDynamicClient() (*dynamic.DynamicClient, error) {
+// CreateDynamicClientWithTimeout returns a client whose requests are aborted after timeout, when not 0
+func (m *ClusterRegistrar) CreateDynamicClientWithTimeout(timeout time.Duration) (*dynamic.DynamicClient, error) {
 	if m.restConfig == nil {
 		if err := m.buildConfig(); err != nil {
 			return nil, err
 		}
 	}
 
-	client, err := dynamic.NewForConfig(m.restConfig)
+	config := rest.CopyConfig(m.restConfig)
+	config.Timeout = timeout
+	client, err := dynamic.NewForConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create dynamic client: %v", err)
 	}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+)
+
+type ClusterEventType string
+
+const (
+	ClusterAdded    ClusterEventType = "ADDED"
+	ClusterModified ClusterEventType = "MODIFIED"
+	ClusterDeleted  ClusterEventType = "DELETED"
+	// ClusterReset precedes the clusters of a region sent as ADDED when the cursor position can't be
+	// resumed from, consumers drop the clusters they know in the region
+	ClusterReset ClusterEventType = "RESET"
+	// ClusterWarning reports a region whose events are delayed or missing
+	ClusterWarning ClusterEventType = "WARNING"
+)
+
+// ClusterEvent is a change of a cluster, its resource version is the position of the event in the
+// stream of its region. RESET and WARNING events are about the whole region and have no cluster.
+type ClusterEvent struct {
+	Type            ClusterEventType
+	Region          string
+	ResourceVersion string
+	Cluster         *Cluster
+	Message         string
+}
+
+type ClusterWatcher interface {
+	// Watch sends the cluster events of regions, every region when empty, until ctx is done or the
+	// channel is closed because the consumer fell behind. Events after the resource versions of
+	// cursor are replayed first, the clusters of the other regions are sent as ADDED. It returns a
+	// NotFoundError when one of regions doesn't exist.
+	Watch(ctx context.Context, regions []string, cursor map[string]string) (<-chan ClusterEvent, error)
+}
+
+// ParseWatchCursor decodes a cursor formatted by FormatWatchCursor.
+func ParseWatchCursor(value string) (map[string]string, error) {
+	cursor := map[string]string{}
+	if value == "" {
+		return cursor, nil
+	}
+
+	for _, position := range strings.Split(value, ",") {
+		region, resourceVersion, ok := strings.Cut(position, "=")
+		if !ok || region == "" || resourceVersion == "" {
+			return nil, errors.NewInvalidArgumentError(fmt.Sprintf("invalid watch cursor %s", value))
+		}
+
+		cursor[region] = resourceVersion
+	}
+
+	return cursor, nil
+}
+
+// FormatWatchCursor encodes the resource version reached in each region, as region=version pairs.
+func FormatWatchCursor(cursor map[string]string) string {
+	positions := make([]string, 0, len(cursor))
+	for region, resourceVersion := range cursor {
+		positions = append(positions, region+"="+resourceVersion)
+	}
+	sort.Strings(positions)
+
+	return strings.Join(positions, ",")
+}
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WatchCursor(t *testing.T) {
+	cursor, err := ParseWatchCursor("")
+	require.NoError(t, err)
+	assert.Empty(t, cursor)
+
+	cursor, err = ParseWatchCursor("us=12,eu=1337")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"eu": "1337", "us": "12"}, cursor)
+	assert.Equal(t, "eu=1337,us=12", FormatWatchCursor(cursor))
+
+	for _, invalid := range []string{"eu", "eu=", "=12", "eu=1,"} {
+		_, err := ParseWatchCursor(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,300 @@
+package api
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+// ControlPlanePolicyAnnotation holds the JSON encoded ControlPlanePolicy of a registrar.
+const ControlPlanePolicyAnnotation = "malygos.local/control-plane-policy"
+
+// ControlPlanePolicy sets the control plane defaults of a region and bounds what clusters may request.
+type ControlPlanePolicy struct {
+	Defaults            ControlPlane              `json:"defaults"`
+	MaxReplicas         *int32                    `json:"max_replicas,omitempty"`
+	AllowedServiceTypes []ControlPlaneServiceType `json:"allowed_service_types,omitempty"`
+	AllowedDatastores   []string                  `json:"allowed_datastores,omitempty"`
+	// MaxCPU and MaxMemory bound the requests and limits of each component
+	MaxCPU    *string `json:"max_cpu,omitempty"`
+	MaxMemory *string `json:"max_memory,omitempty"`
+}
+
+// DefaultControlPlane is used for the fields neither the cluster nor the policy of its region set.
+func DefaultControlPlane() *ControlPlane {
+	return &ControlPlane{
+		Replicas:    ptr.To(int32(3)),
+		ServiceType: ptr.To(ClusterIP),
+		Datastore:   ptr.To("default"),
+		Resources:   &ControlPlaneResources{},
+	}
+}
+
+// Apply fills the unset fields of controlPlane with the policy defaults and checks the result
+// against the policy limits. A nil policy only applies DefaultControlPlane. Only the fields the
+// capabilities support are defaulted, nil capabilities support them all.
+func (p *ControlPlanePolicy) Apply(controlPlane *ControlPlane, capabilities *ClusterCapabilities) (*ControlPlane, error) {
+	resolved := &ControlPlane{}
+	if controlPlane != nil {
+		*resolved = *controlPlane
+	}
+
+	if p != nil {
+		mergeControlPlane(resolved, capabilities.supported(&p.Defaults))
+	}
+	mergeControlPlane(resolved, capabilities.supported(DefaultControlPlane()))
+
+	if err := validateControlPlane(resolved); err != nil {
+		return nil, err
+	}
+
+	if p == nil {
+		return resolved, nil
+	}
+
+	if p.MaxReplicas != nil && resolved.Replicas != nil && *resolved.Replicas > *p.MaxReplicas {
+		return nil, errors.NewInvalidArgumentError(fmt.Sprintf("control_plane.replicas must be <= %d", *p.MaxReplicas))
+	}
+
+	if len(p.AllowedServiceTypes) > 0 && resolved.ServiceType != nil && !slices.Contains(p.AllowedServiceTypes, *resolved.ServiceType) {
+		return nil, errors.NewInvalidArgumentError(fmt.Sprintf("control_plane.service_type %s is not allowed in this region", *resolved.ServiceType))
+	}
+
+	if len(p.AllowedDatastores) > 0 && resolved.Datastore != nil && !slices.Contains(p.AllowedDatastores, *resolved.Datastore) {
+		return nil, errors.NewInvalidArgumentError(fmt.Sprintf("control_plane.datastore %s is not allowed in this region", *resolved.Datastore))
+	}
+
+	for name, component := range resolved.Resources.components() {
+		if component == nil {
+			continue
+		}
+
+		for _, list := range []*ResourceList{component.Requests, component.Limits} {
+			if err := checkMaximum(name+" cpu", quantity(list, v1.ResourceCPU), p.MaxCPU); err != nil {
+				return nil, err
+			}
+
+			if err := checkMaximum(name+" memory", quantity(list, v1.ResourceMemory), p.MaxMemory); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return resolved, nil
+}
+
+// mergeControlPlane sets the fields of dst that are unset to their value in defaults
+func mergeControlPlane(dst *ControlPlane, defaults *ControlPlane) {
+	if dst.Replicas == nil {
+		dst.Replicas = defaults.Replicas
+	}
+
+	if dst.ServiceType == nil {
+		dst.ServiceType = defaults.ServiceType
+	}
+
+	if dst.Datastore == nil {
+		dst.Datastore = defaults.Datastore
+	}
+
+	if defaults.Resources == nil {
+		return
+	}
+
+	resources := &ControlPlaneResources{}
+	if dst.Resources != nil {
+		*resources = *dst.Resources
+	}
+	resources.Apiserver = mergeComponentResources(resources.Apiserver, defaults.Resources.Apiserver)
+	resources.ControllerManager = mergeComponentResources(resources.ControllerManager, defaults.Resources.ControllerManager)
+	resources.Scheduler = mergeComponentResources(resources.Scheduler, defaults.Resources.Scheduler)
+	resources.Kine = mergeComponentResources(resources.Kine, defaults.Resources.Kine)
+	dst.Resources = resources
+}
+
+func mergeComponentResources(component *ComponentResources, defaults *ComponentResources) *ComponentResources {
+	if component == nil {
+		return defaults
+	}
+
+	if defaults == nil {
+		return component
+	}
+
+	merged := *component
+	if merged.Requests == nil {
+		merged.Requests = defaults.Requests
+	}
+
+	if merged.Limits == nil {
+		merged.Limits = defaults.Limits
+	}
+
+	return &merged
+}
+
+// validateControlPlane checks the fields which are set, the ones a provider doesn't support stay unset
+func validateControlPlane(controlPlane *ControlPlane) error {
+	if controlPlane.Replicas != nil && *controlPlane.Replicas < 1 {
+		return errors.NewInvalidArgumentError("control_plane.replicas must be >= 1")
+	}
+
+	if controlPlane.ServiceType != nil {
+		switch *controlPlane.ServiceType {
+		case ClusterIP, NodePort, LoadBalancer:
+		default:
+			return errors.NewInvalidArgumentError(fmt.Sprintf("control_plane.service_type %s is not supported", *controlPlane.ServiceType))
+		}
+	}
+
+	if controlPlane.Datastore != nil && *controlPlane.Datastore == "" {
+		return errors.NewInvalidArgumentError("control_plane.datastore must be non empty")
+	}
+
+	for name, component := range controlPlane.Resources.components() {
+		if component == nil {
+			continue
+		}
+
+		for _, list := range []*ResourceList{component.Requests, component.Limits} {
+			if list == nil {
+				continue
+			}
+
+			for _, value := range []*string{list.Cpu, list.Memory} {
+				if value == nil {
+					continue
+				}
+
+				if _, err := resource.ParseQuantity(*value); err != nil {
+					return errors.NewInvalidArgumentError(fmt.Sprintf("control_plane.resources.%s: invalid quantity %s", name, *value))
+				}
+			}
+		}
+
+		requirements := component.ResourceRequirements()
+		for resourceName, limit := range requirements.Limits {
+			if request, ok := requirements.Requests[resourceName]; ok && request.Cmp(limit) > 0 {
+				return errors.NewInvalidArgumentError(fmt.Sprintf("control_plane.resources.%s: %s request is above its limit", name, resourceName))
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkMaximum(name string, value *resource.Quantity, maximum *string) error {
+	if value == nil || maximum == nil {
+		return nil
+	}
+
+	limit, err := resource.ParseQuantity(*maximum)
+	if err != nil {
+		return fmt.Errorf("invalid control plane policy maximum %s: %v", *maximum, err)
+	}
+
+	if value.Cmp(limit) > 0 {
+		return errors.NewInvalidArgumentError(fmt.Sprintf("control_plane.resources.%s must be <= %s", name, limit.String()))
+	}
+
+	return nil
+}
+
+func quantity(list *ResourceList, name v1.ResourceName) *resource.Quantity {
+	if list == nil {
+		return nil
+	}
+
+	value := list.Cpu
+	if name == v1.ResourceMemory {
+		value = list.Memory
+	}
+
+	if value == nil {
+		return nil
+	}
+
+	q, err := resource.ParseQuantity(*value)
+	if err != nil {
+		return nil
+	}
+
+	return &q
+}
+
+func (r *ControlPlaneResources) components() map[string]*ComponentResources {
+	if r == nil {
+		return nil
+	}
+
+	return map[string]*ComponentResources{
+		"apiserver":          r.Apiserver,
+		"controller_manager": r.ControllerManager,
+		"scheduler":          r.Scheduler,
+		"kine":               r.Kine,
+	}
+}
+
+// ResourceRequirements converts the component resources, quantities are expected to be validated.
+func (r *ComponentResources) ResourceRequirements() v1.ResourceRequirements {
+	requirements := v1.ResourceRequirements{}
+	if r == nil {
+		return requirements
+	}
+
+	requirements.Requests = toResourceList(r.Requests)
+	requirements.Limits = toResourceList(r.Limits)
+	return requirements
+}
+
+func toResourceList(list *ResourceList) v1.ResourceList {
+	if list == nil {
+		return nil
+	}
+
+	resources := v1.ResourceList{}
+	for _, name := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+		if q := quantity(list, name); q != nil {
+			resources[name] = *q
+		}
+	}
+
+	if len(resources) == 0 {
+		return nil
+	}
+
+	return resources
+}
+
+// FromResourceRequirements converts Kubernetes resource requirements back to component resources.
+func FromResourceRequirements(requirements *v1.ResourceRequirements) *ComponentResources {
+	if requirements == nil || (len(requirements.Requests) == 0 && len(requirements.Limits) == 0) {
+		return nil
+	}
+
+	return &ComponentResources{
+		Requests: fromResourceList(requirements.Requests),
+		Limits:   fromResourceList(requirements.Limits),
+	}
+}
+
+func fromResourceList(resources v1.ResourceList) *ResourceList {
+	if len(resources) == 0 {
+		return nil
+	}
+
+	list := &ResourceList{}
+	if cpu, ok := resources[v1.ResourceCPU]; ok {
+		list.Cpu = ptr.To(cpu.String())
+	}
+
+	if memory, ok := resources[v1.ResourceMemory]; ok {
+		list.Memory = ptr.To(memory.String())
+	}
+
+	return list
+}
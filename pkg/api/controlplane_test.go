@@ -0,0 +1,82 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func Test_ControlPlanePolicy_Apply(t *testing.T) {
+	var noPolicy *ControlPlanePolicy
+	controlPlane, err := noPolicy.Apply(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), *controlPlane.Replicas)
+	assert.Equal(t, ClusterIP, *controlPlane.ServiceType)
+	assert.Equal(t, "default", *controlPlane.Datastore)
+
+	policy := &ControlPlanePolicy{
+		Defaults: ControlPlane{
+			Replicas: ptr.To(int32(2)),
+			Resources: &ControlPlaneResources{
+				Apiserver: &ComponentResources{
+					Requests: &ResourceList{Cpu: ptr.To("250m"), Memory: ptr.To("256Mi")},
+					Limits:   &ResourceList{Cpu: ptr.To("1"), Memory: ptr.To("1Gi")},
+				},
+			},
+		},
+		MaxReplicas:         ptr.To(int32(3)),
+		AllowedServiceTypes: []ControlPlaneServiceType{ClusterIP, LoadBalancer},
+		AllowedDatastores:   []string{"default", "etcd"},
+		MaxCPU:              ptr.To("2"),
+		MaxMemory:           ptr.To("4Gi"),
+	}
+
+	controlPlane, err = policy.Apply(&ControlPlane{
+		Replicas:    ptr.To(int32(1)),
+		ServiceType: ptr.To(LoadBalancer),
+		Resources: &ControlPlaneResources{
+			Apiserver: &ComponentResources{Requests: &ResourceList{Cpu: ptr.To("500m")}},
+		},
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), *controlPlane.Replicas)
+	assert.Equal(t, LoadBalancer, *controlPlane.ServiceType)
+	assert.Equal(t, "default", *controlPlane.Datastore)
+	assert.Equal(t, "500m", *controlPlane.Resources.Apiserver.Requests.Cpu)
+	assert.Nil(t, controlPlane.Resources.Apiserver.Requests.Memory)
+	assert.Equal(t, "1Gi", *controlPlane.Resources.Apiserver.Limits.Memory)
+
+	controlPlane, err = policy.Apply(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), *controlPlane.Replicas)
+	assert.Equal(t, "256Mi", *controlPlane.Resources.Apiserver.Requests.Memory)
+
+	invalid := []*ControlPlane{
+		{Replicas: ptr.To(int32(0))},
+		{Replicas: ptr.To(int32(5))},
+		{ServiceType: ptr.To(NodePort)},
+		{ServiceType: ptr.To(ControlPlaneServiceType("ExternalName"))},
+		{Datastore: ptr.To("mysql")},
+		{Resources: &ControlPlaneResources{Kine: &ComponentResources{Limits: &ResourceList{Cpu: ptr.To("4")}}}},
+		{Resources: &ControlPlaneResources{Kine: &ComponentResources{Requests: &ResourceList{Memory: ptr.To("lots")}}}},
+		{Resources: &ControlPlaneResources{Scheduler: &ComponentResources{
+			Requests: &ResourceList{Cpu: ptr.To("1")},
+			Limits:   &ResourceList{Cpu: ptr.To("500m")},
+		}}},
+	}
+
+	for i, controlPlane := range invalid {
+		_, err := policy.Apply(controlPlane, nil)
+		assert.True(t, errors.IsInvalidArgument(err), "control plane %d: %v", i, err)
+	}
+
+	// only the fields supported by the provider are defaulted
+	capabilities := &ClusterCapabilities{Provider: "capi", ControlPlaneReplicas: true}
+	controlPlane, err = policy.Apply(nil, capabilities)
+	require.NoError(t, err)
+	assert.Equal(t, &ControlPlane{Replicas: ptr.To(int32(2))}, controlPlane)
+	assert.NoError(t, capabilities.CheckCluster(&Cluster{ControlPlane: controlPlane}))
+}
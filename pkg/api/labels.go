@@ -0,0 +1,113 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// UserLabelPrefix is added to the user labels and annotations stored on the cluster objects, so they
+	// can't overwrite the ones Malygos and the provisioners rely on
+	UserLabelPrefix = "malygos.local/user-"
+	// maxUserAnnotationsSize keeps a margin below the 256KiB limit of the object annotations
+	maxUserAnnotationsSize = 64 * 1024
+)
+
+// ValidateUserLabels checks that user labels can be stored once prefixed, keys can't have a prefix.
+func ValidateUserLabels(userLabels map[string]string) error {
+	for key, value := range userLabels {
+		if err := validateUserKey("label", key); err != nil {
+			return err
+		}
+
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return errors.NewInvalidArgumentError(fmt.Sprintf("label %s value is invalid: %s", key, strings.Join(errs, ", ")))
+		}
+	}
+
+	return nil
+}
+
+// ValidateUserAnnotations checks that user annotations can be stored once prefixed.
+func ValidateUserAnnotations(userAnnotations map[string]string) error {
+	size := 0
+	for key, value := range userAnnotations {
+		if err := validateUserKey("annotation", key); err != nil {
+			return err
+		}
+		size += len(key) + len(value)
+	}
+
+	if size > maxUserAnnotationsSize {
+		return errors.NewInvalidArgumentError(fmt.Sprintf("annotations must not exceed %d bytes", maxUserAnnotationsSize))
+	}
+
+	return nil
+}
+
+func validateUserKey(kind string, key string) error {
+	if strings.Contains(key, "/") {
+		return errors.NewInvalidArgumentError(fmt.Sprintf("%s key %s must not have a prefix", kind, key))
+	}
+
+	if errs := validation.IsQualifiedName(UserLabelPrefix + key); len(errs) > 0 {
+		return errors.NewInvalidArgumentError(fmt.Sprintf("%s key %s is invalid: %s", kind, key, strings.Join(errs, ", ")))
+	}
+
+	return nil
+}
+
+// PrefixUserLabels returns the user labels or annotations as stored on the cluster objects
+func PrefixUserLabels(userLabels map[string]string) map[string]string {
+	prefixed := make(map[string]string, len(userLabels))
+	for key, value := range userLabels {
+		prefixed[UserLabelPrefix+key] = value
+	}
+
+	return prefixed
+}
+
+// UserLabels extracts the user labels or annotations of a cluster object, nil when there is none
+func UserLabels(objectLabels map[string]string) *map[string]string {
+	userLabels := map[string]string{}
+	for key, value := range objectLabels {
+		if name, ok := strings.CutPrefix(key, UserLabelPrefix); ok {
+			userLabels[name] = value
+		}
+	}
+
+	if len(userLabels) == 0 {
+		return nil
+	}
+
+	return &userLabels
+}
+
+// UserLabelSelector parses a selector on user labels, in the Kubernetes syntax, and returns it on the
+// stored label keys so that it can be passed to the registrars.
+func UserLabelSelector(selector string) (labels.Selector, error) {
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		return nil, errors.NewInvalidArgumentError(fmt.Sprintf("invalid label selector: %v", err))
+	}
+
+	requirements, _ := parsed.Requirements()
+	prefixed := labels.NewSelector()
+	for _, requirement := range requirements {
+		if err := validateUserKey("label", requirement.Key()); err != nil {
+			return nil, err
+		}
+
+		r, err := labels.NewRequirement(UserLabelPrefix+requirement.Key(), requirement.Operator(), requirement.Values().List())
+		if err != nil {
+			return nil, errors.NewInvalidArgumentError(fmt.Sprintf("invalid label selector: %v", err))
+		}
+		prefixed = prefixed.Add(*r)
+	}
+
+	return prefixed, nil
+}
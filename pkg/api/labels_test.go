@@ -0,0 +1,45 @@
+package api
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateUserLabels(t *testing.T) {
+	assert.NoError(t, ValidateUserLabels(map[string]string{"env": "dev", "ticket": "ABC-123", "empty": ""}))
+	assert.True(t, errors.IsInvalidArgument(ValidateUserLabels(map[string]string{"example.com/env": "dev"})))
+	assert.True(t, errors.IsInvalidArgument(ValidateUserLabels(map[string]string{"env": "not valid"})))
+	assert.True(t, errors.IsInvalidArgument(ValidateUserLabels(map[string]string{strings.Repeat("a", 60): "dev"})))
+
+	assert.NoError(t, ValidateUserAnnotations(map[string]string{"description": "payments cluster, see ABC-123"}))
+	assert.True(t, errors.IsInvalidArgument(ValidateUserAnnotations(map[string]string{"a b": "c"})))
+	assert.True(t, errors.IsInvalidArgument(ValidateUserAnnotations(map[string]string{"big": strings.Repeat("a", maxUserAnnotationsSize)})))
+}
+
+func Test_UserLabels(t *testing.T) {
+	stored := PrefixUserLabels(map[string]string{"env": "dev"})
+	assert.Equal(t, map[string]string{"malygos.local/user-env": "dev"}, stored)
+
+	stored["malygos.local/region"] = "eu"
+	assert.Equal(t, &map[string]string{"env": "dev"}, UserLabels(stored))
+	assert.Nil(t, UserLabels(map[string]string{"malygos.local/region": "eu"}))
+}
+
+func Test_UserLabelSelector(t *testing.T) {
+	selector, err := UserLabelSelector("env=dev,team in (payments,billing),!legacy")
+	require.NoError(t, err)
+	assert.Equal(t, "malygos.local/user-env=dev,!malygos.local/user-legacy,malygos.local/user-team in (billing,payments)", selector.String())
+
+	selector, err = UserLabelSelector("")
+	require.NoError(t, err)
+	assert.True(t, selector.Empty())
+
+	_, err = UserLabelSelector("env in (dev")
+	assert.True(t, errors.IsInvalidArgument(err))
+	_, err = UserLabelSelector("malygos.local/region=eu")
+	assert.True(t, errors.IsInvalidArgument(err))
+}
@@ -2,8 +2,8 @@ package api
 
 import (
 	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/audit"
 	"k8s.io/client-go/dynamic"
-	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
 
@@ -11,8 +11,18 @@ type Manager interface {
 	GetKubeconfig() *rest.Config
 	GetClusterRegistrar() ClusterRegistrarManager
 
-	InstanciateClusterManager(logr.Logger, *kubernetes.Clientset, *dynamic.DynamicClient) ClusterManager
+	// InstanciateClusterManager builds the cluster manager of the provider of registrar on top of client
+	InstanciateClusterManager(logger logr.Logger, registrar *ClusterRegistrar, client dynamic.Interface) (ClusterManager, error)
 	GetClusterManager(region string) (ClusterManager, error)
+	// GetClusterCapabilities returns the capabilities of the provider of a region
+	GetClusterCapabilities(region string) (*ClusterCapabilities, error)
+	// GetClusterProviders returns the names of the providers registrars can declare
+	GetClusterProviders() []string
 	GetCatalog() CatalogManager
 	GetRBAC() RBAC
+	GetTokenManager() TokenManager
+	GetAuditor() *audit.Auditor
+	GetQuotaManager() QuotaManager
+	GetClusterWatcher() ClusterWatcher
+	GetOperationManager() OperationManager
 }
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Kamaji defaults, used to validate networks that only set some of the ranges
+const (
+	DefaultPodCIDR     = "10.244.0.0/16"
+	DefaultServiceCIDR = "10.96.0.0/16"
+)
+
+// Validate checks the addresses and ranges of a network profile, unset ranges are checked
+// with their default value as they end up deployed together.
+func (n *Network) Validate() error {
+	if n.Address != nil && net.ParseIP(*n.Address) == nil {
+		return errors.NewInvalidArgumentError(fmt.Sprintf("network.address %s is not a valid IP address", *n.Address))
+	}
+
+	if n.Port != nil && (*n.Port < 1 || *n.Port > 65535) {
+		return errors.NewInvalidArgumentError("network.port must be between 1 and 65535")
+	}
+
+	if n.CertSans != nil {
+		for _, san := range *n.CertSans {
+			if err := validateSAN(san); err != nil {
+				return err
+			}
+		}
+	}
+
+	podCIDR, err := parseCIDR("network.pod_cidr", n.PodCidr, DefaultPodCIDR)
+	if err != nil {
+		return err
+	}
+
+	serviceCIDR, err := parseCIDR("network.service_cidr", n.ServiceCidr, DefaultServiceCIDR)
+	if err != nil {
+		return err
+	}
+
+	// dual-stack is not supported, both ranges have to be of the same family
+	if (podCIDR.IP.To4() == nil) != (serviceCIDR.IP.To4() == nil) {
+		return errors.NewInvalidArgumentError(fmt.Sprintf("network.pod_cidr %s and network.service_cidr %s must both be IPv4 or IPv6", podCIDR, serviceCIDR))
+	}
+
+	if podCIDR.Contains(serviceCIDR.IP) || serviceCIDR.Contains(podCIDR.IP) {
+		return errors.NewInvalidArgumentError(fmt.Sprintf("network.pod_cidr %s overlaps network.service_cidr %s", podCIDR, serviceCIDR))
+	}
+
+	if n.DnsServiceIps == nil || len(*n.DnsServiceIps) == 0 {
+		if _, err := DefaultDNSServiceIP(serviceCIDR.String()); err != nil {
+			return errors.NewInvalidArgumentError(fmt.Sprintf("network.service_cidr %s has no room for the cluster DNS address, set network.dns_service_ips", serviceCIDR))
+		}
+	} else {
+		for _, address := range *n.DnsServiceIps {
+			ip := net.ParseIP(address)
+			if ip == nil {
+				return errors.NewInvalidArgumentError(fmt.Sprintf("network.dns_service_ips: %s is not a valid IP address", address))
+			}
+
+			if !serviceCIDR.Contains(ip) {
+				return errors.NewInvalidArgumentError(fmt.Sprintf("network.dns_service_ips: %s is not within the service CIDR %s", address, serviceCIDR))
+			}
+		}
+	}
+
+	return nil
+}
+
+// DefaultDNSServiceIP returns the network address of a service CIDR plus 10 (10.96.0.10 for 10.96.0.0/16),
+// which kubeadm reserves for the cluster DNS.
+func DefaultDNSServiceIP(serviceCIDR string) (string, error) {
+	_, network, err := net.ParseCIDR(serviceCIDR)
+	if err != nil {
+		return "", fmt.Errorf("invalid service CIDR %s: %v", serviceCIDR, err)
+	}
+
+	ip := new(big.Int).SetBytes(network.IP)
+	ip.Add(ip, big.NewInt(10))
+	b := ip.Bytes()
+	if len(b) > len(network.IP) {
+		return "", fmt.Errorf("service CIDR %s is too small", serviceCIDR)
+	}
+
+	address := make(net.IP, len(network.IP))
+	copy(address[len(address)-len(b):], b)
+	if !network.Contains(address) {
+		return "", fmt.Errorf("service CIDR %s is too small", serviceCIDR)
+	}
+
+	return address.String(), nil
+}
+
+func parseCIDR(field string, value *string, defaultValue string) (*net.IPNet, error) {
+	cidr := defaultValue
+	if value != nil {
+		cidr = *value
+	}
+
+	ip, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, errors.NewInvalidArgumentError(fmt.Sprintf("%s %s is not a valid CIDR", field, cidr))
+	}
+
+	if !ip.Equal(network.IP) {
+		return nil, errors.NewInvalidArgumentError(fmt.Sprintf("%s %s has host bits set, use %s", field, cidr, network))
+	}
+
+	return network, nil
+}
+
+func validateSAN(san string) error {
+	if net.ParseIP(san) != nil {
+		return nil
+	}
+
+	errs := validation.IsDNS1123Subdomain(san)
+	if strings.HasPrefix(san, "*.") {
+		errs = validation.IsWildcardDNS1123Subdomain(san)
+	}
+
+	if len(errs) > 0 {
+		return errors.NewInvalidArgumentError(fmt.Sprintf("network.cert_sans: %s is neither an IP address nor a valid DNS name", san))
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func Test_Network_Validate(t *testing.T) {
+	valid := []*Network{
+		{},
+		{
+			Address:       ptr.To("192.168.1.10"),
+			Port:          ptr.To(int32(443)),
+			CertSans:      ptr.To([]string{"api.example.com", "*.example.com", "10.0.0.1"}),
+			PodCidr:       ptr.To("172.16.0.0/16"),
+			ServiceCidr:   ptr.To("172.17.0.0/16"),
+			DnsServiceIps: ptr.To([]string{"172.17.0.10"}),
+		},
+		{ServiceCidr: ptr.To("fd00:10::/108"), PodCidr: ptr.To("fd00:20::/64")},
+	}
+
+	for i, network := range valid {
+		assert.NoError(t, network.Validate(), "network %d", i)
+	}
+
+	invalid := []*Network{
+		{Address: ptr.To("api.example.com")},
+		{Port: ptr.To(int32(0))},
+		{Port: ptr.To(int32(70000))},
+		{CertSans: ptr.To([]string{"not a name"})},
+		{CertSans: ptr.To([]string{"api..example.com"})},
+		{PodCidr: ptr.To("10.244.0.0")},
+		{PodCidr: ptr.To("10.244.0.1/16")},
+		{PodCidr: ptr.To("10.0.0.0/8")},
+		{ServiceCidr: ptr.To("10.244.128.0/20")},
+		{ServiceCidr: ptr.To("172.17.0.0/29")},
+		{ServiceCidr: ptr.To("172.17.0.0/16"), DnsServiceIps: ptr.To([]string{"10.96.0.10"})},
+		{DnsServiceIps: ptr.To([]string{"dns"})},
+		{ServiceCidr: ptr.To("fd00:10::/108")},
+		{PodCidr: ptr.To("fd00:20::/64"), ServiceCidr: ptr.To("172.17.0.0/16")},
+	}
+
+	for i, network := range invalid {
+		assert.True(t, errors.IsInvalidArgument(network.Validate()), "network %d", i)
+	}
+}
+
+func Test_DefaultDNSServiceIP(t *testing.T) {
+	address, err := DefaultDNSServiceIP("10.96.0.0/16")
+	require.NoError(t, err)
+	assert.Equal(t, "10.96.0.10", address)
+
+	address, err = DefaultDNSServiceIP("fd00:10::/108")
+	require.NoError(t, err)
+	assert.Equal(t, "fd00:10::a", address)
+
+	_, err = DefaultDNSServiceIP("10.96.0.0/29")
+	assert.Error(t, err)
+}
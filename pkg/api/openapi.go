@@ -15,6 +15,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
@@ -22,14 +23,97 @@ import (
 )
 
 const (
-	BasicScopes      = "basic.Scopes"
 	BasicAuthScopes  = "basicAuth.Scopes"
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AuditEventOutcome.
+const (
+	Denied  AuditEventOutcome = "denied"
+	Failure AuditEventOutcome = "failure"
+	Success AuditEventOutcome = "success"
+)
+
+// Defines values for ClusterConditionStatus.
+const (
+	False   ClusterConditionStatus = "False"
+	True    ClusterConditionStatus = "True"
+	Unknown ClusterConditionStatus = "Unknown"
+)
+
+// Defines values for ControlPlaneServiceType.
+const (
+	ClusterIP    ControlPlaneServiceType = "ClusterIP"
+	LoadBalancer ControlPlaneServiceType = "LoadBalancer"
+	NodePort     ControlPlaneServiceType = "NodePort"
+)
+
+// Defines values for OperationType.
+const (
+	Create OperationType = "create"
+	Delete OperationType = "delete"
+	Update OperationType = "update"
+)
+
+// Defines values for OperationState.
+const (
+	Failed    OperationState = "failed"
+	Running   OperationState = "running"
+	Succeeded OperationState = "succeeded"
+)
+
+// AccessReview defines model for AccessReview.
+type AccessReview struct {
+	Allowed  bool      `json:"allowed"`
+	Groups   *[]string `json:"groups,omitempty"`
+	Username string    `json:"username"`
+}
+
+// Addon defines model for Addon.
+type Addon struct {
+	Enabled bool `json:"enabled"`
+
+	// ImageRepository Registry to pull the addon image from
+	ImageRepository *string `json:"image_repository,omitempty"`
+
+	// ImageTag Image tag, pinning it stops the addon from following cluster upgrades
+	ImageTag *string `json:"image_tag,omitempty"`
+}
+
+// Addons Addons deployed in the cluster, only CoreDNS is enabled when not set at creation
+type Addons struct {
+	Coredns      *Addon             `json:"coredns,omitempty"`
+	Konnectivity *KonnectivityAddon `json:"konnectivity,omitempty"`
+	KubeProxy    *Addon             `json:"kube_proxy,omitempty"`
+}
+
+// AuditEvent defines model for AuditEvent.
+type AuditEvent struct {
+	Action  string             `json:"action"`
+	Actor   string             `json:"actor"`
+	Details *map[string]string `json:"details,omitempty"`
+	Error   *string            `json:"error,omitempty"`
+	Groups  *[]string          `json:"groups,omitempty"`
+	Id      *string            `json:"id,omitempty"`
+
+	// Impersonator Authenticated user when the actor was impersonated
+	Impersonator *string           `json:"impersonator,omitempty"`
+	Outcome      AuditEventOutcome `json:"outcome"`
+	Region       *string           `json:"region,omitempty"`
+	RequestId    *string           `json:"request_id,omitempty"`
+	Resource     string            `json:"resource"`
+	Time         time.Time         `json:"time"`
+}
+
+// AuditEventOutcome defines model for AuditEvent.Outcome.
+type AuditEventOutcome string
+
 // Catalog defines model for Catalog.
 type Catalog struct {
 	Components []CatalogComponent `json:"components"`
+
+	// Continue Token to get the next page, not set on the last page
+	Continue *string `json:"continue,omitempty"`
 }
 
 // CatalogComponent defines model for CatalogComponent.
@@ -56,18 +140,132 @@ type CatalogComponentVersion struct {
 
 // Cluster defines model for Cluster.
 type Cluster struct {
-	Id         *string        `json:"id,omitempty"`
-	Kubeconfig *Kubeconfig    `json:"kubeconfig,omitempty"`
-	Name       string         `json:"name"`
-	Region     string         `json:"region"`
-	Status     *ClusterStatus `json:"status,omitempty"`
-	Version    string         `json:"version"`
+	// Addons Addons deployed in the cluster, only CoreDNS is enabled when not set at creation
+	Addons *Addons `json:"addons,omitempty"`
+
+	// Annotations User annotations, keys can't have a prefix
+	Annotations *map[string]string `json:"annotations,omitempty"`
+
+	// ControlPlane Control plane sizing, unset fields are filled with the defaults of the region
+	ControlPlane *ControlPlane `json:"control_plane,omitempty"`
+	Id           *string       `json:"id,omitempty"`
+	Kubeconfig   *Kubeconfig   `json:"kubeconfig,omitempty"`
+
+	// Labels User labels, keys can't have a prefix. Clusters can be listed by label
+	Labels *map[string]string `json:"labels,omitempty"`
+	Name   string             `json:"name"`
+
+	// Network Network profile of the cluster, unset fields are defaulted by the provisioner
+	Network *Network `json:"network,omitempty"`
+
+	// Owner User who created the cluster, set by the server
+	Owner  *string        `json:"owner,omitempty"`
+	Region string         `json:"region"`
+	Status *ClusterStatus `json:"status,omitempty"`
+
+	// Team Group owning the cluster, its members can manage it
+	Team    *string `json:"team,omitempty"`
+	Version string  `json:"version"`
+}
+
+// ClusterCondition defines model for ClusterCondition.
+type ClusterCondition struct {
+	// LastTransitionTime Last time the status changed, only set on conditions the provider reports transitions for
+	LastTransitionTime *time.Time `json:"last_transition_time,omitempty"`
+
+	// LastUpdateTime Last time the provider updated the state the condition is derived from
+	LastUpdateTime *time.Time             `json:"last_update_time,omitempty"`
+	Message        *string                `json:"message,omitempty"`
+	Reason         string                 `json:"reason"`
+	Status         ClusterConditionStatus `json:"status"`
+
+	// Type Ready, EndpointReady, DatastoreReady, KubeconfigReady, ControlPlaneAvailable, the conditions of the Kamaji control plane deployment prefixed with Deployment or the conditions of the Cluster API cluster prefixed with Cluster
+	Type string `json:"type"`
+}
+
+// ClusterConditionStatus defines model for ClusterCondition.Status.
+type ClusterConditionStatus string
+
+// ClusterKubeconfig defines model for ClusterKubeconfig.
+type ClusterKubeconfig struct {
+	Kubeconfig Kubeconfig `json:"kubeconfig"`
+}
+
+// ClusterOwnership defines model for ClusterOwnership.
+type ClusterOwnership struct {
+	// Owner New owner, unchanged when not set
+	Owner *string `json:"owner,omitempty"`
+
+	// Team New owning team, an empty value removes the team
+	Team *string `json:"team,omitempty"`
 }
 
 // ClusterStatus defines model for ClusterStatus.
 type ClusterStatus struct {
-	Online bool   `json:"online"`
-	Phase  string `json:"phase"`
+	Conditions *[]ClusterCondition `json:"conditions,omitempty"`
+	Datastore  *DatastoreStatus    `json:"datastore,omitempty"`
+
+	// Endpoint Address and port the API server is reachable on
+	Endpoint *string `json:"endpoint,omitempty"`
+
+	// KubeconfigReady Whether the admin kubeconfig has been generated
+	KubeconfigReady *bool  `json:"kubeconfig_ready,omitempty"`
+	Online          bool   `json:"online"`
+	Phase           string `json:"phase"`
+
+	// Port Port of the control plane service
+	Port     *int32          `json:"port,omitempty"`
+	Replicas *ReplicasStatus `json:"replicas,omitempty"`
+
+	// Upgrading Whether the control plane is being upgraded to the cluster version
+	Upgrading *bool `json:"upgrading,omitempty"`
+
+	// Version Kubernetes version currently running, differs from the cluster version during upgrades
+	Version *string `json:"version,omitempty"`
+}
+
+// ClusterUpdate defines model for ClusterUpdate.
+type ClusterUpdate struct {
+	// Addons Addons deployed in the cluster, only CoreDNS is enabled when not set at creation
+	Addons *Addons `json:"addons,omitempty"`
+
+	// Version Kubernetes version to upgrade to, at most one minor version above the current one
+	Version *string `json:"version,omitempty"`
+}
+
+// ComponentResources defines model for ComponentResources.
+type ComponentResources struct {
+	Limits   *ResourceList `json:"limits,omitempty"`
+	Requests *ResourceList `json:"requests,omitempty"`
+}
+
+// ControlPlane Control plane sizing, unset fields are filled with the defaults of the region
+type ControlPlane struct {
+	// Datastore Name of the Kamaji datastore storing the cluster state
+	Datastore   *string                  `json:"datastore,omitempty"`
+	Replicas    *int32                   `json:"replicas,omitempty"`
+	Resources   *ControlPlaneResources   `json:"resources,omitempty"`
+	ServiceType *ControlPlaneServiceType `json:"service_type,omitempty"`
+}
+
+// ControlPlaneServiceType defines model for ControlPlane.ServiceType.
+type ControlPlaneServiceType string
+
+// ControlPlaneResources defines model for ControlPlaneResources.
+type ControlPlaneResources struct {
+	Apiserver         *ComponentResources `json:"apiserver,omitempty"`
+	ControllerManager *ComponentResources `json:"controller_manager,omitempty"`
+	Kine              *ComponentResources `json:"kine,omitempty"`
+	Scheduler         *ComponentResources `json:"scheduler,omitempty"`
+}
+
+// DatastoreStatus defines model for DatastoreStatus.
+type DatastoreStatus struct {
+	Driver *string `json:"driver,omitempty"`
+	Name   *string `json:"name,omitempty"`
+
+	// Ready Whether the cluster schema and user have been set up on the datastore
+	Ready bool `json:"ready"`
 }
 
 // Error defines model for Error.
@@ -75,15 +273,130 @@ type Error struct {
 	Error string `json:"error"`
 }
 
+// KonnectivityAddon defines model for KonnectivityAddon.
+type KonnectivityAddon struct {
+	Enabled bool `json:"enabled"`
+
+	// ServerPort Port the Konnectivity server listens on, defaults to 8132
+	ServerPort *int32 `json:"server_port,omitempty"`
+
+	// Version Version of the Konnectivity server and agent images
+	Version *string `json:"version,omitempty"`
+}
+
 // Kubeconfig defines model for Kubeconfig.
 type Kubeconfig = string
 
+// Network Network profile of the cluster, unset fields are defaulted by the provisioner
+type Network struct {
+	// Address IP address the API server is advertised on
+	Address *string `json:"address,omitempty"`
+
+	// CertSans Extra hostnames or IP addresses of the API server certificate
+	CertSans *[]string `json:"cert_sans,omitempty"`
+
+	// DnsServiceIps Cluster DNS service addresses, within the service CIDR
+	DnsServiceIps *[]string `json:"dns_service_ips,omitempty"`
+	PodCidr       *string   `json:"pod_cidr,omitempty"`
+
+	// Port Port the API server is exposed on
+	Port        *int32  `json:"port,omitempty"`
+	ServiceCidr *string `json:"service_cidr,omitempty"`
+}
+
+// Operation defines model for Operation.
+type Operation struct {
+	ClusterId *string   `json:"cluster_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Error Reason of the failure of a failed operation
+	Error      *string    `json:"error,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	Id         string     `json:"id"`
+
+	// Messages Progress messages, oldest first
+	Messages []OperationMessage `json:"messages"`
+	Owner    string             `json:"owner"`
+	Region   string             `json:"region"`
+	Result   *Cluster           `json:"result,omitempty"`
+	State    OperationState     `json:"state"`
+
+	// TargetVersion Kubernetes version the cluster is upgraded to by an update
+	TargetVersion *string       `json:"target_version,omitempty"`
+	Type          OperationType `json:"type"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+}
+
+// OperationType defines model for Operation.Type.
+type OperationType string
+
+// OperationMessage defines model for OperationMessage.
+type OperationMessage struct {
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
+}
+
+// OperationState defines model for OperationState.
+type OperationState string
+
+// Quota defines model for Quota.
+type Quota struct {
+	Limits QuotaLimits `json:"limits"`
+	Name   string      `json:"name"`
+
+	// Region Region the quota applies to, every region is counted when not set
+	Region *string `json:"region,omitempty"`
+
+	// Team Team the quota applies to, every cluster is counted when not set
+	Team  *string     `json:"team,omitempty"`
+	Usage *QuotaUsage `json:"usage,omitempty"`
+}
+
+// QuotaLimits defines model for QuotaLimits.
+type QuotaLimits struct {
+	Clusters *int64 `json:"clusters,omitempty"`
+
+	// Cpu Total control plane CPU requests, as a Kubernetes quantity
+	Cpu *string `json:"cpu,omitempty"`
+
+	// Memory Total control plane memory requests, as a Kubernetes quantity
+	Memory *string `json:"memory,omitempty"`
+
+	// Replicas Total number of control plane replicas
+	Replicas *int64 `json:"replicas,omitempty"`
+}
+
+// QuotaUsage defines model for QuotaUsage.
+type QuotaUsage struct {
+	Clusters int64  `json:"clusters"`
+	Cpu      string `json:"cpu"`
+	Memory   string `json:"memory"`
+	Replicas int64  `json:"replicas"`
+}
+
 // RegistrarCluster defines model for RegistrarCluster.
 type RegistrarCluster struct {
 	Id         *string     `json:"id,omitempty"`
 	Kubeconfig *Kubeconfig `json:"kubeconfig,omitempty"`
 	Name       string      `json:"name"`
-	Region     string      `json:"region"`
+
+	// Provider Provider managing the clusters of the region, kamaji when not set
+	Provider *string `json:"provider,omitempty"`
+	Region   string  `json:"region"`
+}
+
+// ReplicasStatus defines model for ReplicasStatus.
+type ReplicasStatus struct {
+	Available int32 `json:"available"`
+	Desired   int32 `json:"desired"`
+	Ready     int32 `json:"ready"`
+	Updated   int32 `json:"updated"`
+}
+
+// ResourceList defines model for ResourceList.
+type ResourceList struct {
+	Cpu    *string `json:"cpu,omitempty"`
+	Memory *string `json:"memory,omitempty"`
 }
 
 // SubscribedClusters defines model for SubscribedClusters.
@@ -94,6 +407,72 @@ type SubscribedClusters struct {
 	} `json:"clusters"`
 }
 
+// Token defines model for Token.
+type Token struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	Id         string     `json:"id"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Name       string     `json:"name"`
+	Owner      string     `json:"owner"`
+	Scopes     *[]string  `json:"scopes,omitempty"`
+	Token      *string    `json:"token,omitempty"`
+	Username   string     `json:"username"`
+}
+
+// TokenRequest defines model for TokenRequest.
+type TokenRequest struct {
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Name      string     `json:"name"`
+
+	// Scopes Restricts the token to the given action:resource pairs, for example create:cluster or *:cluster.
+	// The token is unrestricted when no scope is set.
+	Scopes         *[]string `json:"scopes,omitempty"`
+	ServiceAccount *string   `json:"service_account,omitempty"`
+}
+
+// ListAuditEventsParams defines parameters for ListAuditEvents.
+type ListAuditEventsParams struct {
+	// Actor Only return events of this user
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Resource Only return events on this resource kind (cluster, managementcluster, token...)
+	Resource *string `form:"resource,omitempty" json:"resource,omitempty"`
+
+	// Since Only return events which happened after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only return events which happened before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit Maximum number of events to return, the most recent are kept (default 100, max 1000)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CanIParams defines parameters for CanI.
+type CanIParams struct {
+	// Action Action to check (create, list, get, delete, subscribe...)
+	Action string `form:"action" json:"action"`
+
+	// Resource Resource kind to check (cluster, managementcluster, catalog_component...)
+	Resource string `form:"resource" json:"resource"`
+
+	// Region Region the action targets
+	Region *string `form:"region,omitempty" json:"region,omitempty"`
+
+	// Id Object the action targets
+	Id *string `form:"id,omitempty" json:"id,omitempty"`
+}
+
+// ListCatalogComponentsParams defines parameters for ListCatalogComponents.
+type ListCatalogComponentsParams struct {
+	// Limit Maximum number of items to return, at most 500, every item is returned when not set
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Token returned with the previous page, to use with the same parameters
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+}
+
 // UnsubscribeCatalogComponentVersionParams defines parameters for UnsubscribeCatalogComponentVersion.
 type UnsubscribeCatalogComponentVersionParams struct {
 	// Region Region to unsubscribe from
@@ -112,6 +491,54 @@ type SubscribeCatalogComponentVersionParams struct {
 	ClusterId string `form:"clusterId" json:"clusterId"`
 }
 
+// ListClustersParams defines parameters for ListClusters.
+type ListClustersParams struct {
+	// LabelSelector Only return the clusters matching this selector on their labels, in the Kubernetes syntax
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Limit Maximum number of items to return, at most 500, every item is returned when not set
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Token returned with the previous page, to use with the same parameters
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+}
+
+// WatchClustersParams defines parameters for WatchClusters.
+type WatchClustersParams struct {
+	// Region Only watch the clusters of this region
+	Region *string `form:"region,omitempty" json:"region,omitempty"`
+
+	// ClusterId Only watch this cluster
+	ClusterId *string `form:"cluster_id,omitempty" json:"cluster_id,omitempty"`
+
+	// ResourceVersion Cursor of the last received event
+	ResourceVersion *string `form:"resource_version,omitempty" json:"resource_version,omitempty"`
+}
+
+// DeleteClusterParams defines parameters for DeleteCluster.
+type DeleteClusterParams struct {
+	// Wait Wait for the cluster to be removed
+	Wait *bool `form:"wait,omitempty" json:"wait,omitempty"`
+
+	// Timeout Maximum duration to wait for, such as 90s or 5m, 2m by default and at most 10m
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
+// ListOperationsParams defines parameters for ListOperations.
+type ListOperationsParams struct {
+	// State Only return operations in this state
+	State *OperationState `form:"state,omitempty" json:"state,omitempty"`
+}
+
+// ListRegistrarClustersParams defines parameters for ListRegistrarClusters.
+type ListRegistrarClustersParams struct {
+	// Limit Maximum number of items to return, at most 500, every item is returned when not set
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Token returned with the previous page, to use with the same parameters
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+}
+
 // AddCatalogComponentJSONRequestBody defines body for AddCatalogComponent for application/json ContentType.
 type AddCatalogComponentJSONRequestBody = CatalogComponent
 
@@ -121,9 +548,18 @@ type AddCatalogComponentVersionJSONRequestBody = CatalogComponentVersion
 // CreateClusterJSONRequestBody defines body for CreateCluster for application/json ContentType.
 type CreateClusterJSONRequestBody = Cluster
 
+// UpdateClusterJSONRequestBody defines body for UpdateCluster for application/json ContentType.
+type UpdateClusterJSONRequestBody = ClusterUpdate
+
+// TransferClusterOwnershipJSONRequestBody defines body for TransferClusterOwnership for application/json ContentType.
+type TransferClusterOwnershipJSONRequestBody = ClusterOwnership
+
 // CreateRegistrarClusterJSONRequestBody defines body for CreateRegistrarCluster for application/json ContentType.
 type CreateRegistrarClusterJSONRequestBody = RegistrarCluster
 
+// CreateTokenJSONRequestBody defines body for CreateToken for application/json ContentType.
+type CreateTokenJSONRequestBody = TokenRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -197,8 +633,14 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// ListAuditEvents request
+	ListAuditEvents(ctx context.Context, params *ListAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CanI request
+	CanI(ctx context.Context, params *CanIParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListCatalogComponents request
-	ListCatalogComponents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListCatalogComponents(ctx context.Context, params *ListCatalogComponentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// AddCatalogComponentWithBody request with any body
 	AddCatalogComponentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -232,24 +674,49 @@ type ClientInterface interface {
 	SubscribeCatalogComponentVersion(ctx context.Context, componentName string, componentVersion string, params *SubscribeCatalogComponentVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListClusters request
-	ListClusters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListClusters(ctx context.Context, params *ListClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateClusterWithBody request with any body
 	CreateClusterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CreateCluster(ctx context.Context, body CreateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WatchClusters request
+	WatchClusters(ctx context.Context, params *WatchClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteCluster request
-	DeleteCluster(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteCluster(ctx context.Context, region string, clusterId string, params *DeleteClusterParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetCluster request
 	GetCluster(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateClusterWithBody request with any body
+	UpdateClusterWithBody(ctx context.Context, region string, clusterId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCluster(ctx context.Context, region string, clusterId string, body UpdateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClusterKubeconfig request
+	GetClusterKubeconfig(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransferClusterOwnershipWithBody request with any body
+	TransferClusterOwnershipWithBody(ctx context.Context, region string, clusterId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TransferClusterOwnership(ctx context.Context, region string, clusterId string, body TransferClusterOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListClusterSubscriptions request
 	ListClusterSubscriptions(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListOperations request
+	ListOperations(ctx context.Context, params *ListOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOperation request
+	GetOperation(ctx context.Context, operationId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListQuotas request
+	ListQuotas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListRegistrarClusters request
-	ListRegistrarClusters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListRegistrarClusters(ctx context.Context, params *ListRegistrarClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateRegistrarClusterWithBody request with any body
 	CreateRegistrarClusterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -261,10 +728,45 @@ type ClientInterface interface {
 
 	// GetRegistrarCluster request
 	GetRegistrarCluster(ctx context.Context, clusterRegistrarId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTokens request
+	ListTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTokenWithBody request with any body
+	CreateTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateToken(ctx context.Context, body CreateTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteToken request
+	DeleteToken(ctx context.Context, tokenId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListAuditEvents(ctx context.Context, params *ListAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAuditEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CanI(ctx context.Context, params *CanIParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCanIRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) ListCatalogComponents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListCatalogComponentsRequest(c.Server)
+func (c *Client) ListCatalogComponents(ctx context.Context, params *ListCatalogComponentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCatalogComponentsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -407,8 +909,8 @@ func (c *Client) SubscribeCatalogComponentVersion(ctx context.Context, component
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListClusters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListClustersRequest(c.Server)
+func (c *Client) ListClusters(ctx context.Context, params *ListClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClustersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -443,8 +945,8 @@ func (c *Client) CreateCluster(ctx context.Context, body CreateClusterJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteCluster(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteClusterRequest(c.Server, region, clusterId)
+func (c *Client) WatchClusters(ctx context.Context, params *WatchClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWatchClustersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -455,8 +957,8 @@ func (c *Client) DeleteCluster(ctx context.Context, region string, clusterId str
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCluster(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetClusterRequest(c.Server, region, clusterId)
+func (c *Client) DeleteCluster(ctx context.Context, region string, clusterId string, params *DeleteClusterParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteClusterRequest(c.Server, region, clusterId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -467,8 +969,8 @@ func (c *Client) GetCluster(ctx context.Context, region string, clusterId string
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListClusterSubscriptions(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListClusterSubscriptionsRequest(c.Server, region, clusterId)
+func (c *Client) GetCluster(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClusterRequest(c.Server, region, clusterId)
 	if err != nil {
 		return nil, err
 	}
@@ -479,8 +981,8 @@ func (c *Client) ListClusterSubscriptions(ctx context.Context, region string, cl
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListRegistrarClusters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListRegistrarClustersRequest(c.Server)
+func (c *Client) UpdateClusterWithBody(ctx context.Context, region string, clusterId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClusterRequestWithBody(c.Server, region, clusterId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -491,8 +993,8 @@ func (c *Client) ListRegistrarClusters(ctx context.Context, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateRegistrarClusterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateRegistrarClusterRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateCluster(ctx context.Context, region string, clusterId string, body UpdateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClusterRequest(c.Server, region, clusterId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -503,8 +1005,8 @@ func (c *Client) CreateRegistrarClusterWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateRegistrarCluster(ctx context.Context, body CreateRegistrarClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateRegistrarClusterRequest(c.Server, body)
+func (c *Client) GetClusterKubeconfig(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClusterKubeconfigRequest(c.Server, region, clusterId)
 	if err != nil {
 		return nil, err
 	}
@@ -515,8 +1017,8 @@ func (c *Client) CreateRegistrarCluster(ctx context.Context, body CreateRegistra
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteRegistrarCluster(ctx context.Context, clusterRegistrarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteRegistrarClusterRequest(c.Server, clusterRegistrarId)
+func (c *Client) TransferClusterOwnershipWithBody(ctx context.Context, region string, clusterId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferClusterOwnershipRequestWithBody(c.Server, region, clusterId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -527,8 +1029,8 @@ func (c *Client) DeleteRegistrarCluster(ctx context.Context, clusterRegistrarId
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetRegistrarCluster(ctx context.Context, clusterRegistrarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetRegistrarClusterRequest(c.Server, clusterRegistrarId)
+func (c *Client) TransferClusterOwnership(ctx context.Context, region string, clusterId string, body TransferClusterOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferClusterOwnershipRequest(c.Server, region, clusterId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -539,212 +1041,172 @@ func (c *Client) GetRegistrarCluster(ctx context.Context, clusterRegistrarId str
 	return c.Client.Do(req)
 }
 
-// NewListCatalogComponentsRequest generates requests for ListCatalogComponents
-func NewListCatalogComponentsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ListClusterSubscriptions(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClusterSubscriptionsRequest(c.Server, region, clusterId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/catalog")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListOperations(ctx context.Context, params *ListOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOperationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAddCatalogComponentRequest calls the generic AddCatalogComponent builder with application/json body
-func NewAddCatalogComponentRequest(server string, body AddCatalogComponentJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetOperation(ctx context.Context, operationId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOperationRequest(c.Server, operationId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddCatalogComponentRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAddCatalogComponentRequestWithBody generates requests for AddCatalogComponent with any type of body
-func NewAddCatalogComponentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ListQuotas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListQuotasRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/catalog/components")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListRegistrarClusters(ctx context.Context, params *ListRegistrarClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRegistrarClustersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteCatalogComponentRequest generates requests for DeleteCatalogComponent
-func NewDeleteCatalogComponentRequest(server string, componentName string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentName", runtime.ParamLocationPath, componentName)
+func (c *Client) CreateRegistrarClusterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRegistrarClusterRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/v1/catalog/components/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateRegistrarCluster(ctx context.Context, body CreateRegistrarClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRegistrarClusterRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetCatalogComponentRequest generates requests for GetCatalogComponent
-func NewGetCatalogComponentRequest(server string, componentName string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentName", runtime.ParamLocationPath, componentName)
+func (c *Client) DeleteRegistrarCluster(ctx context.Context, clusterRegistrarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRegistrarClusterRequest(c.Server, clusterRegistrarId)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/v1/catalog/components/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetRegistrarCluster(ctx context.Context, clusterRegistrarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRegistrarClusterRequest(c.Server, clusterRegistrarId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAddCatalogComponentVersionRequest calls the generic AddCatalogComponentVersion builder with application/json body
-func NewAddCatalogComponentVersionRequest(server string, componentName string, body AddCatalogComponentVersionJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTokensRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddCatalogComponentVersionRequestWithBody(server, componentName, "application/json", bodyReader)
-}
-
-// NewAddCatalogComponentVersionRequestWithBody generates requests for AddCatalogComponentVersion with any type of body
-func NewAddCatalogComponentVersionRequestWithBody(server string, componentName string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentName", runtime.ParamLocationPath, componentName)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTokenRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/catalog/components/%s/versions", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateToken(ctx context.Context, body CreateTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTokenRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteCatalogComponentVersionRequest generates requests for DeleteCatalogComponentVersion
-func NewDeleteCatalogComponentVersionRequest(server string, componentName string, componentVersion string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentName", runtime.ParamLocationPath, componentName)
+func (c *Client) DeleteToken(ctx context.Context, tokenId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTokenRequest(c.Server, tokenId)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "componentVersion", runtime.ParamLocationPath, componentVersion)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
+
+// NewListAuditEventsRequest generates requests for ListAuditEvents
+func NewListAuditEventsRequest(server string, params *ListAuditEventsParams) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/catalog/components/%s/versions/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/audit")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -754,45 +1216,90 @@ func NewDeleteCatalogComponentVersionRequest(server string, componentName string
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewGetCatalogComponentVersionRequest generates requests for GetCatalogComponentVersion
-func NewGetCatalogComponentVersionRequest(server string, componentName string, componentVersion string) (*http.Request, error) {
-	var err error
+		}
 
-	var pathParam0 string
+		if params.Resource != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resource", runtime.ParamLocationQuery, *params.Resource); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentName", runtime.ParamLocationPath, componentName)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	var pathParam1 string
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "componentVersion", runtime.ParamLocationPath, componentVersion)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/v1/catalog/components/%s/versions/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -803,30 +1310,16 @@ func NewGetCatalogComponentVersionRequest(server string, componentName string, c
 	return req, nil
 }
 
-// NewUnsubscribeCatalogComponentVersionRequest generates requests for UnsubscribeCatalogComponentVersion
-func NewUnsubscribeCatalogComponentVersionRequest(server string, componentName string, componentVersion string, params *UnsubscribeCatalogComponentVersionParams) (*http.Request, error) {
+// NewCanIRequest generates requests for CanI
+func NewCanIRequest(server string, params *CanIParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentName", runtime.ParamLocationPath, componentName)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "componentVersion", runtime.ParamLocationPath, componentVersion)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/catalog/components/%s/versions/%s/subscriptions", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/auth/can-i")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -839,7 +1332,7 @@ func NewUnsubscribeCatalogComponentVersionRequest(server string, componentName s
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "region", runtime.ParamLocationQuery, params.Region); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, params.Action); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -851,7 +1344,7 @@ func NewUnsubscribeCatalogComponentVersionRequest(server string, componentName s
 			}
 		}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "clusterId", runtime.ParamLocationQuery, params.ClusterId); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resource", runtime.ParamLocationQuery, params.Resource); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -863,10 +1356,42 @@ func NewUnsubscribeCatalogComponentVersionRequest(server string, componentName s
 			}
 		}
 
+		if params.Region != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "region", runtime.ParamLocationQuery, *params.Region); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -874,30 +1399,16 @@ func NewUnsubscribeCatalogComponentVersionRequest(server string, componentName s
 	return req, nil
 }
 
-// NewListCatalogComponentVersionSubscriptionsRequest generates requests for ListCatalogComponentVersionSubscriptions
-func NewListCatalogComponentVersionSubscriptionsRequest(server string, componentName string, componentVersion string) (*http.Request, error) {
+// NewListCatalogComponentsRequest generates requests for ListCatalogComponents
+func NewListCatalogComponentsRequest(server string, params *ListCatalogComponentsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentName", runtime.ParamLocationPath, componentName)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "componentVersion", runtime.ParamLocationPath, componentVersion)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/catalog/components/%s/versions/%s/subscriptions", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/catalog")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -907,38 +1418,73 @@ func NewListCatalogComponentVersionSubscriptionsRequest(server string, component
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewSubscribeCatalogComponentVersionRequest generates requests for SubscribeCatalogComponentVersion
-func NewSubscribeCatalogComponentVersionRequest(server string, componentName string, componentVersion string, params *SubscribeCatalogComponentVersionParams) (*http.Request, error) {
-	var err error
+		}
 
-	var pathParam0 string
+		if params.Continue != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "continue", runtime.ParamLocationQuery, *params.Continue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentName", runtime.ParamLocationPath, componentName)
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	return req, nil
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "componentVersion", runtime.ParamLocationPath, componentVersion)
+// NewAddCatalogComponentRequest calls the generic AddCatalogComponent builder with application/json body
+func NewAddCatalogComponentRequest(server string, body AddCatalogComponentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddCatalogComponentRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddCatalogComponentRequestWithBody generates requests for AddCatalogComponent with any type of body
+func NewAddCatalogComponentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/catalog/components/%s/versions/%s/subscriptions", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/catalog/components")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -948,37 +1494,43 @@ func NewSubscribeCatalogComponentVersionRequest(server string, componentName str
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "region", runtime.ParamLocationQuery, params.Region); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	req.Header.Add("Content-Type", contentType)
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "clusterId", runtime.ParamLocationQuery, params.ClusterId); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	return req, nil
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+// NewDeleteCatalogComponentRequest generates requests for DeleteCatalogComponent
+func NewDeleteCatalogComponentRequest(server string, componentName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentName", runtime.ParamLocationPath, componentName)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/catalog/components/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -986,16 +1538,23 @@ func NewSubscribeCatalogComponentVersionRequest(server string, componentName str
 	return req, nil
 }
 
-// NewListClustersRequest generates requests for ListClusters
-func NewListClustersRequest(server string) (*http.Request, error) {
+// NewGetCatalogComponentRequest generates requests for GetCatalogComponent
+func NewGetCatalogComponentRequest(server string, componentName string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentName", runtime.ParamLocationPath, componentName)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clusters")
+	operationPath := fmt.Sprintf("/v1/catalog/components/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1013,27 +1572,34 @@ func NewListClustersRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCreateClusterRequest calls the generic CreateCluster builder with application/json body
-func NewCreateClusterRequest(server string, body CreateClusterJSONRequestBody) (*http.Request, error) {
+// NewAddCatalogComponentVersionRequest calls the generic AddCatalogComponentVersion builder with application/json body
+func NewAddCatalogComponentVersionRequest(server string, componentName string, body AddCatalogComponentVersionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateClusterRequestWithBody(server, "application/json", bodyReader)
+	return NewAddCatalogComponentVersionRequestWithBody(server, componentName, "application/json", bodyReader)
 }
 
-// NewCreateClusterRequestWithBody generates requests for CreateCluster with any type of body
-func NewCreateClusterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewAddCatalogComponentVersionRequestWithBody generates requests for AddCatalogComponentVersion with any type of body
+func NewAddCatalogComponentVersionRequestWithBody(server string, componentName string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentName", runtime.ParamLocationPath, componentName)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clusters")
+	operationPath := fmt.Sprintf("/v1/catalog/components/%s/versions", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1053,20 +1619,20 @@ func NewCreateClusterRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
-// NewDeleteClusterRequest generates requests for DeleteCluster
-func NewDeleteClusterRequest(server string, region string, clusterId string) (*http.Request, error) {
+// NewDeleteCatalogComponentVersionRequest generates requests for DeleteCatalogComponentVersion
+func NewDeleteCatalogComponentVersionRequest(server string, componentName string, componentVersion string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "region", runtime.ParamLocationPath, region)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentName", runtime.ParamLocationPath, componentName)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterId", runtime.ParamLocationPath, clusterId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "componentVersion", runtime.ParamLocationPath, componentVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -1076,7 +1642,7 @@ func NewDeleteClusterRequest(server string, region string, clusterId string) (*h
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clusters/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/catalog/components/%s/versions/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1094,20 +1660,20 @@ func NewDeleteClusterRequest(server string, region string, clusterId string) (*h
 	return req, nil
 }
 
-// NewGetClusterRequest generates requests for GetCluster
-func NewGetClusterRequest(server string, region string, clusterId string) (*http.Request, error) {
+// NewGetCatalogComponentVersionRequest generates requests for GetCatalogComponentVersion
+func NewGetCatalogComponentVersionRequest(server string, componentName string, componentVersion string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "region", runtime.ParamLocationPath, region)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentName", runtime.ParamLocationPath, componentName)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterId", runtime.ParamLocationPath, clusterId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "componentVersion", runtime.ParamLocationPath, componentVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -1117,7 +1683,7 @@ func NewGetClusterRequest(server string, region string, clusterId string) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clusters/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/catalog/components/%s/versions/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1135,20 +1701,20 @@ func NewGetClusterRequest(server string, region string, clusterId string) (*http
 	return req, nil
 }
 
-// NewListClusterSubscriptionsRequest generates requests for ListClusterSubscriptions
-func NewListClusterSubscriptionsRequest(server string, region string, clusterId string) (*http.Request, error) {
+// NewUnsubscribeCatalogComponentVersionRequest generates requests for UnsubscribeCatalogComponentVersion
+func NewUnsubscribeCatalogComponentVersionRequest(server string, componentName string, componentVersion string, params *UnsubscribeCatalogComponentVersionParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "region", runtime.ParamLocationPath, region)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentName", runtime.ParamLocationPath, componentName)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterId", runtime.ParamLocationPath, clusterId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "componentVersion", runtime.ParamLocationPath, componentVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -1158,7 +1724,7 @@ func NewListClusterSubscriptionsRequest(server string, region string, clusterId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clusters/%s/%s/subscriptions", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/catalog/components/%s/versions/%s/subscriptions", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1168,7 +1734,37 @@ func NewListClusterSubscriptionsRequest(server string, region string, clusterId
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "region", runtime.ParamLocationQuery, params.Region); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "clusterId", runtime.ParamLocationQuery, params.ClusterId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1176,54 +1772,30 @@ func NewListClusterSubscriptionsRequest(server string, region string, clusterId
 	return req, nil
 }
 
-// NewListRegistrarClustersRequest generates requests for ListRegistrarClusters
-func NewListRegistrarClustersRequest(server string) (*http.Request, error) {
+// NewListCatalogComponentVersionSubscriptionsRequest generates requests for ListCatalogComponentVersionSubscriptions
+func NewListCatalogComponentVersionSubscriptionsRequest(server string, componentName string, componentVersion string) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/v1/registrars")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	var pathParam0 string
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentName", runtime.ParamLocationPath, componentName)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	var pathParam1 string
 
-// NewCreateRegistrarClusterRequest calls the generic CreateRegistrarCluster builder with application/json body
-func NewCreateRegistrarClusterRequest(server string, body CreateRegistrarClusterJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "componentVersion", runtime.ParamLocationPath, componentVersion)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateRegistrarClusterRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewCreateRegistrarClusterRequestWithBody generates requests for CreateRegistrarCluster with any type of body
-func NewCreateRegistrarClusterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/registrars")
+	operationPath := fmt.Sprintf("/v1/catalog/components/%s/versions/%s/subscriptions", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1233,23 +1805,28 @@ func NewCreateRegistrarClusterRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteRegistrarClusterRequest generates requests for DeleteRegistrarCluster
-func NewDeleteRegistrarClusterRequest(server string, clusterRegistrarId string) (*http.Request, error) {
+// NewSubscribeCatalogComponentVersionRequest generates requests for SubscribeCatalogComponentVersion
+func NewSubscribeCatalogComponentVersionRequest(server string, componentName string, componentVersion string, params *SubscribeCatalogComponentVersionParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clusterRegistrarId", runtime.ParamLocationPath, clusterRegistrarId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentName", runtime.ParamLocationPath, componentName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "componentVersion", runtime.ParamLocationPath, componentVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -1259,7 +1836,7 @@ func NewDeleteRegistrarClusterRequest(server string, clusterRegistrarId string)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/registrars/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/catalog/components/%s/versions/%s/subscriptions", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1269,7 +1846,37 @@ func NewDeleteRegistrarClusterRequest(server string, clusterRegistrarId string)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "region", runtime.ParamLocationQuery, params.Region); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "clusterId", runtime.ParamLocationQuery, params.ClusterId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1277,23 +1884,16 @@ func NewDeleteRegistrarClusterRequest(server string, clusterRegistrarId string)
 	return req, nil
 }
 
-// NewGetRegistrarClusterRequest generates requests for GetRegistrarCluster
-func NewGetRegistrarClusterRequest(server string, clusterRegistrarId string) (*http.Request, error) {
+// NewListClustersRequest generates requests for ListClusters
+func NewListClustersRequest(server string, params *ListClustersParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clusterRegistrarId", runtime.ParamLocationPath, clusterRegistrarId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/registrars/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clusters")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1303,804 +1903,2553 @@ func NewGetRegistrarClusterRequest(server string, clusterRegistrarId string) (*h
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.LabelSelector != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
 		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
-	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+		if params.Continue != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "continue", runtime.ParamLocationQuery, *params.Continue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+
+	return req, nil
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// NewCreateClusterRequest calls the generic CreateCluster builder with application/json body
+func NewCreateClusterRequest(server string, body CreateClusterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateClusterRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ListCatalogComponentsWithResponse request
-	ListCatalogComponentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCatalogComponentsResponse, error)
-
-	// AddCatalogComponentWithBodyWithResponse request with any body
-	AddCatalogComponentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddCatalogComponentResponse, error)
-
-	AddCatalogComponentWithResponse(ctx context.Context, body AddCatalogComponentJSONRequestBody, reqEditors ...RequestEditorFn) (*AddCatalogComponentResponse, error)
+// NewCreateClusterRequestWithBody generates requests for CreateCluster with any type of body
+func NewCreateClusterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// DeleteCatalogComponentWithResponse request
-	DeleteCatalogComponentWithResponse(ctx context.Context, componentName string, reqEditors ...RequestEditorFn) (*DeleteCatalogComponentResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetCatalogComponentWithResponse request
-	GetCatalogComponentWithResponse(ctx context.Context, componentName string, reqEditors ...RequestEditorFn) (*GetCatalogComponentResponse, error)
+	operationPath := fmt.Sprintf("/v1/clusters")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AddCatalogComponentVersionWithBodyWithResponse request with any body
-	AddCatalogComponentVersionWithBodyWithResponse(ctx context.Context, componentName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddCatalogComponentVersionResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	AddCatalogComponentVersionWithResponse(ctx context.Context, componentName string, body AddCatalogComponentVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*AddCatalogComponentVersionResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteCatalogComponentVersionWithResponse request
-	DeleteCatalogComponentVersionWithResponse(ctx context.Context, componentName string, componentVersion string, reqEditors ...RequestEditorFn) (*DeleteCatalogComponentVersionResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetCatalogComponentVersionWithResponse request
-	GetCatalogComponentVersionWithResponse(ctx context.Context, componentName string, componentVersion string, reqEditors ...RequestEditorFn) (*GetCatalogComponentVersionResponse, error)
+	return req, nil
+}
 
-	// UnsubscribeCatalogComponentVersionWithResponse request
-	UnsubscribeCatalogComponentVersionWithResponse(ctx context.Context, componentName string, componentVersion string, params *UnsubscribeCatalogComponentVersionParams, reqEditors ...RequestEditorFn) (*UnsubscribeCatalogComponentVersionResponse, error)
+// NewWatchClustersRequest generates requests for WatchClusters
+func NewWatchClustersRequest(server string, params *WatchClustersParams) (*http.Request, error) {
+	var err error
 
-	// ListCatalogComponentVersionSubscriptionsWithResponse request
-	ListCatalogComponentVersionSubscriptionsWithResponse(ctx context.Context, componentName string, componentVersion string, reqEditors ...RequestEditorFn) (*ListCatalogComponentVersionSubscriptionsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// SubscribeCatalogComponentVersionWithResponse request
-	SubscribeCatalogComponentVersionWithResponse(ctx context.Context, componentName string, componentVersion string, params *SubscribeCatalogComponentVersionParams, reqEditors ...RequestEditorFn) (*SubscribeCatalogComponentVersionResponse, error)
+	operationPath := fmt.Sprintf("/v1/clusters/watch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ListClustersWithResponse request
-	ListClustersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClustersResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateClusterWithBodyWithResponse request with any body
-	CreateClusterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	CreateClusterWithResponse(ctx context.Context, body CreateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterResponse, error)
+		if params.Region != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "region", runtime.ParamLocationQuery, *params.Region); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// DeleteClusterWithResponse request
-	DeleteClusterWithResponse(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*DeleteClusterResponse, error)
+		}
 
-	// GetClusterWithResponse request
-	GetClusterWithResponse(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*GetClusterResponse, error)
+		if params.ClusterId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cluster_id", runtime.ParamLocationQuery, *params.ClusterId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// ListClusterSubscriptionsWithResponse request
-	ListClusterSubscriptionsWithResponse(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*ListClusterSubscriptionsResponse, error)
+		}
 
-	// ListRegistrarClustersWithResponse request
-	ListRegistrarClustersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRegistrarClustersResponse, error)
+		if params.ResourceVersion != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resource_version", runtime.ParamLocationQuery, *params.ResourceVersion); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// CreateRegistrarClusterWithBodyWithResponse request with any body
-	CreateRegistrarClusterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRegistrarClusterResponse, error)
+		}
 
-	CreateRegistrarClusterWithResponse(ctx context.Context, body CreateRegistrarClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRegistrarClusterResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// DeleteRegistrarClusterWithResponse request
-	DeleteRegistrarClusterWithResponse(ctx context.Context, clusterRegistrarId string, reqEditors ...RequestEditorFn) (*DeleteRegistrarClusterResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetRegistrarClusterWithResponse request
-	GetRegistrarClusterWithResponse(ctx context.Context, clusterRegistrarId string, reqEditors ...RequestEditorFn) (*GetRegistrarClusterResponse, error)
+	return req, nil
 }
 
-type ListCatalogComponentsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Catalog
-}
+// NewDeleteClusterRequest generates requests for DeleteCluster
+func NewDeleteClusterRequest(server string, region string, clusterId string, params *DeleteClusterParams) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r ListCatalogComponentsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListCatalogComponentsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "region", runtime.ParamLocationPath, region)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AddCatalogComponentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CatalogComponent
-	JSON400      *Error
-	JSON409      *Error
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r AddCatalogComponentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterId", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddCatalogComponentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type DeleteCatalogComponentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON409      *Error
-}
 
-// Status returns HTTPResponse.Status
-func (r DeleteCatalogComponentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/v1/clusters/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteCatalogComponentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetCatalogComponentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CatalogComponent
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r GetCatalogComponentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if params.Wait != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "wait", runtime.ParamLocationQuery, *params.Wait); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Timeout != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeout", runtime.ParamLocationQuery, *params.Timeout); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCatalogComponentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type AddCatalogComponentVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CatalogComponentVersion
-	JSON400      *Error
-	JSON409      *Error
-}
+// NewGetClusterRequest generates requests for GetCluster
+func NewGetClusterRequest(server string, region string, clusterId string) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r AddCatalogComponentVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddCatalogComponentVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "region", runtime.ParamLocationPath, region)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteCatalogComponentVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON409      *Error
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteCatalogComponentVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterId", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteCatalogComponentVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetCatalogComponentVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CatalogComponentVersion
-}
+	operationPath := fmt.Sprintf("/v1/clusters/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetCatalogComponentVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCatalogComponentVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UnsubscribeCatalogComponentVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON409      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UnsubscribeCatalogComponentVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateClusterRequest calls the generic UpdateCluster builder with application/json body
+func NewUpdateClusterRequest(server string, region string, clusterId string, body UpdateClusterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateClusterRequestWithBody(server, region, clusterId, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UnsubscribeCatalogComponentVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewUpdateClusterRequestWithBody generates requests for UpdateCluster with any type of body
+func NewUpdateClusterRequestWithBody(server string, region string, clusterId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type ListCatalogComponentVersionSubscriptionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Subscriptions SubscribedClusters `json:"subscriptions"`
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "region", runtime.ParamLocationPath, region)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r ListCatalogComponentVersionSubscriptionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterId", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListCatalogComponentVersionSubscriptionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type SubscribeCatalogComponentVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON409      *Error
-}
+	operationPath := fmt.Sprintf("/v1/clusters/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r SubscribeCatalogComponentVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SubscribeCatalogComponentVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-type ListClustersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Clusters []Cluster `json:"clusters"`
-		Warnings *[]string `json:"warnings,omitempty"`
+// NewGetClusterKubeconfigRequest generates requests for GetClusterKubeconfig
+func NewGetClusterKubeconfigRequest(server string, region string, clusterId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "region", runtime.ParamLocationPath, region)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r ListClustersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterId", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListClustersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Cluster
-}
+	operationPath := fmt.Sprintf("/v1/clusters/%s/%s/kubeconfig", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewTransferClusterOwnershipRequest calls the generic TransferClusterOwnership builder with application/json body
+func NewTransferClusterOwnershipRequest(server string, region string, clusterId string, body TransferClusterOwnershipJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewTransferClusterOwnershipRequestWithBody(server, region, clusterId, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewTransferClusterOwnershipRequestWithBody generates requests for TransferClusterOwnership with any type of body
+func NewTransferClusterOwnershipRequestWithBody(server string, region string, clusterId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Cluster
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "region", runtime.ParamLocationPath, region)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterId", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clusters/%s/%s/ownership", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListClusterSubscriptionsRequest generates requests for ListClusterSubscriptions
+func NewListClusterSubscriptionsRequest(server string, region string, clusterId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "region", runtime.ParamLocationPath, region)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterId", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clusters/%s/%s/subscriptions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListOperationsRequest generates requests for ListOperations
+func NewListOperationsRequest(server string, params *ListOperationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/operations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.State != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOperationRequest generates requests for GetOperation
+func NewGetOperationRequest(server string, operationId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "operationId", runtime.ParamLocationPath, operationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/operations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListQuotasRequest generates requests for ListQuotas
+func NewListQuotasRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/quotas")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListRegistrarClustersRequest generates requests for ListRegistrarClusters
+func NewListRegistrarClustersRequest(server string, params *ListRegistrarClustersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/registrars")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Continue != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "continue", runtime.ParamLocationQuery, *params.Continue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateRegistrarClusterRequest calls the generic CreateRegistrarCluster builder with application/json body
+func NewCreateRegistrarClusterRequest(server string, body CreateRegistrarClusterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRegistrarClusterRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateRegistrarClusterRequestWithBody generates requests for CreateRegistrarCluster with any type of body
+func NewCreateRegistrarClusterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/registrars")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteRegistrarClusterRequest generates requests for DeleteRegistrarCluster
+func NewDeleteRegistrarClusterRequest(server string, clusterRegistrarId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clusterRegistrarId", runtime.ParamLocationPath, clusterRegistrarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/registrars/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRegistrarClusterRequest generates requests for GetRegistrarCluster
+func NewGetRegistrarClusterRequest(server string, clusterRegistrarId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clusterRegistrarId", runtime.ParamLocationPath, clusterRegistrarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/registrars/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTokensRequest generates requests for ListTokens
+func NewListTokensRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTokenRequest calls the generic CreateToken builder with application/json body
+func NewCreateTokenRequest(server string, body CreateTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTokenRequestWithBody generates requests for CreateToken with any type of body
+func NewCreateTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTokenRequest generates requests for DeleteToken
+func NewDeleteTokenRequest(server string, tokenId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tokenId", runtime.ParamLocationPath, tokenId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListAuditEventsWithResponse request
+	ListAuditEventsWithResponse(ctx context.Context, params *ListAuditEventsParams, reqEditors ...RequestEditorFn) (*ListAuditEventsResponse, error)
+
+	// CanIWithResponse request
+	CanIWithResponse(ctx context.Context, params *CanIParams, reqEditors ...RequestEditorFn) (*CanIResponse, error)
+
+	// ListCatalogComponentsWithResponse request
+	ListCatalogComponentsWithResponse(ctx context.Context, params *ListCatalogComponentsParams, reqEditors ...RequestEditorFn) (*ListCatalogComponentsResponse, error)
+
+	// AddCatalogComponentWithBodyWithResponse request with any body
+	AddCatalogComponentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddCatalogComponentResponse, error)
+
+	AddCatalogComponentWithResponse(ctx context.Context, body AddCatalogComponentJSONRequestBody, reqEditors ...RequestEditorFn) (*AddCatalogComponentResponse, error)
+
+	// DeleteCatalogComponentWithResponse request
+	DeleteCatalogComponentWithResponse(ctx context.Context, componentName string, reqEditors ...RequestEditorFn) (*DeleteCatalogComponentResponse, error)
+
+	// GetCatalogComponentWithResponse request
+	GetCatalogComponentWithResponse(ctx context.Context, componentName string, reqEditors ...RequestEditorFn) (*GetCatalogComponentResponse, error)
+
+	// AddCatalogComponentVersionWithBodyWithResponse request with any body
+	AddCatalogComponentVersionWithBodyWithResponse(ctx context.Context, componentName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddCatalogComponentVersionResponse, error)
+
+	AddCatalogComponentVersionWithResponse(ctx context.Context, componentName string, body AddCatalogComponentVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*AddCatalogComponentVersionResponse, error)
+
+	// DeleteCatalogComponentVersionWithResponse request
+	DeleteCatalogComponentVersionWithResponse(ctx context.Context, componentName string, componentVersion string, reqEditors ...RequestEditorFn) (*DeleteCatalogComponentVersionResponse, error)
+
+	// GetCatalogComponentVersionWithResponse request
+	GetCatalogComponentVersionWithResponse(ctx context.Context, componentName string, componentVersion string, reqEditors ...RequestEditorFn) (*GetCatalogComponentVersionResponse, error)
+
+	// UnsubscribeCatalogComponentVersionWithResponse request
+	UnsubscribeCatalogComponentVersionWithResponse(ctx context.Context, componentName string, componentVersion string, params *UnsubscribeCatalogComponentVersionParams, reqEditors ...RequestEditorFn) (*UnsubscribeCatalogComponentVersionResponse, error)
+
+	// ListCatalogComponentVersionSubscriptionsWithResponse request
+	ListCatalogComponentVersionSubscriptionsWithResponse(ctx context.Context, componentName string, componentVersion string, reqEditors ...RequestEditorFn) (*ListCatalogComponentVersionSubscriptionsResponse, error)
+
+	// SubscribeCatalogComponentVersionWithResponse request
+	SubscribeCatalogComponentVersionWithResponse(ctx context.Context, componentName string, componentVersion string, params *SubscribeCatalogComponentVersionParams, reqEditors ...RequestEditorFn) (*SubscribeCatalogComponentVersionResponse, error)
+
+	// ListClustersWithResponse request
+	ListClustersWithResponse(ctx context.Context, params *ListClustersParams, reqEditors ...RequestEditorFn) (*ListClustersResponse, error)
+
+	// CreateClusterWithBodyWithResponse request with any body
+	CreateClusterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterResponse, error)
+
+	CreateClusterWithResponse(ctx context.Context, body CreateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterResponse, error)
+
+	// WatchClustersWithResponse request
+	WatchClustersWithResponse(ctx context.Context, params *WatchClustersParams, reqEditors ...RequestEditorFn) (*WatchClustersResponse, error)
+
+	// DeleteClusterWithResponse request
+	DeleteClusterWithResponse(ctx context.Context, region string, clusterId string, params *DeleteClusterParams, reqEditors ...RequestEditorFn) (*DeleteClusterResponse, error)
+
+	// GetClusterWithResponse request
+	GetClusterWithResponse(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*GetClusterResponse, error)
+
+	// UpdateClusterWithBodyWithResponse request with any body
+	UpdateClusterWithBodyWithResponse(ctx context.Context, region string, clusterId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterResponse, error)
+
+	UpdateClusterWithResponse(ctx context.Context, region string, clusterId string, body UpdateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterResponse, error)
+
+	// GetClusterKubeconfigWithResponse request
+	GetClusterKubeconfigWithResponse(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*GetClusterKubeconfigResponse, error)
+
+	// TransferClusterOwnershipWithBodyWithResponse request with any body
+	TransferClusterOwnershipWithBodyWithResponse(ctx context.Context, region string, clusterId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransferClusterOwnershipResponse, error)
+
+	TransferClusterOwnershipWithResponse(ctx context.Context, region string, clusterId string, body TransferClusterOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*TransferClusterOwnershipResponse, error)
+
+	// ListClusterSubscriptionsWithResponse request
+	ListClusterSubscriptionsWithResponse(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*ListClusterSubscriptionsResponse, error)
+
+	// ListOperationsWithResponse request
+	ListOperationsWithResponse(ctx context.Context, params *ListOperationsParams, reqEditors ...RequestEditorFn) (*ListOperationsResponse, error)
+
+	// GetOperationWithResponse request
+	GetOperationWithResponse(ctx context.Context, operationId string, reqEditors ...RequestEditorFn) (*GetOperationResponse, error)
+
+	// ListQuotasWithResponse request
+	ListQuotasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListQuotasResponse, error)
+
+	// ListRegistrarClustersWithResponse request
+	ListRegistrarClustersWithResponse(ctx context.Context, params *ListRegistrarClustersParams, reqEditors ...RequestEditorFn) (*ListRegistrarClustersResponse, error)
+
+	// CreateRegistrarClusterWithBodyWithResponse request with any body
+	CreateRegistrarClusterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRegistrarClusterResponse, error)
+
+	CreateRegistrarClusterWithResponse(ctx context.Context, body CreateRegistrarClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRegistrarClusterResponse, error)
+
+	// DeleteRegistrarClusterWithResponse request
+	DeleteRegistrarClusterWithResponse(ctx context.Context, clusterRegistrarId string, reqEditors ...RequestEditorFn) (*DeleteRegistrarClusterResponse, error)
+
+	// GetRegistrarClusterWithResponse request
+	GetRegistrarClusterWithResponse(ctx context.Context, clusterRegistrarId string, reqEditors ...RequestEditorFn) (*GetRegistrarClusterResponse, error)
+
+	// ListTokensWithResponse request
+	ListTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTokensResponse, error)
+
+	// CreateTokenWithBodyWithResponse request with any body
+	CreateTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTokenResponse, error)
+
+	CreateTokenWithResponse(ctx context.Context, body CreateTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTokenResponse, error)
+
+	// DeleteTokenWithResponse request
+	DeleteTokenWithResponse(ctx context.Context, tokenId string, reqEditors ...RequestEditorFn) (*DeleteTokenResponse, error)
+}
+
+type ListAuditEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Events []AuditEvent `json:"events"`
+	}
+	JSON400 *Error
+	JSON501 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAuditEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAuditEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CanIResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AccessReview
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CanIResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CanIResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCatalogComponentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Catalog
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCatalogComponentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCatalogComponentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddCatalogComponentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CatalogComponent
+	JSON400      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AddCatalogComponentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddCatalogComponentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCatalogComponentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCatalogComponentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCatalogComponentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCatalogComponentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogComponent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCatalogComponentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCatalogComponentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddCatalogComponentVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CatalogComponentVersion
+	JSON400      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AddCatalogComponentVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddCatalogComponentVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCatalogComponentVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCatalogComponentVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCatalogComponentVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCatalogComponentVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogComponentVersion
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCatalogComponentVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCatalogComponentVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnsubscribeCatalogComponentVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UnsubscribeCatalogComponentVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnsubscribeCatalogComponentVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCatalogComponentVersionSubscriptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Subscriptions SubscribedClusters `json:"subscriptions"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCatalogComponentVersionSubscriptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCatalogComponentVersionSubscriptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubscribeCatalogComponentVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SubscribeCatalogComponentVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubscribeCatalogComponentVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Clusters []Cluster `json:"clusters"`
+
+		// Continue Token to get the next page, not set on the last page
+		Continue *string   `json:"continue,omitempty"`
+		Warnings *[]string `json:"warnings,omitempty"`
+	}
+	JSON400 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Operation
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WatchClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r WatchClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WatchClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Operation
+	JSON202      *Operation
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Cluster
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Operation
+	JSON400      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClusterKubeconfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterKubeconfig
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClusterKubeconfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClusterKubeconfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransferClusterOwnershipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Cluster
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r TransferClusterOwnershipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransferClusterOwnershipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClusterSubscriptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Subscriptions CatalogComponentVersion `json:"subscriptions"`
+	}
+	JSON501 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClusterSubscriptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClusterSubscriptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOperationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Operations []Operation `json:"operations"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOperationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOperationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOperationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Operation
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOperationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOperationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListQuotasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Quotas   []Quota   `json:"quotas"`
+		Warnings *[]string `json:"warnings,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListQuotasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListQuotasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRegistrarClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Clusters []RegistrarCluster `json:"clusters"`
+
+		// Continue Token to get the next page, not set on the last page
+		Continue *string   `json:"continue,omitempty"`
+		Warnings *[]string `json:"warnings,omitempty"`
+	}
+	JSON400 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRegistrarClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRegistrarClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRegistrarClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RegistrarCluster
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRegistrarClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRegistrarClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRegistrarClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRegistrarClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRegistrarClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRegistrarClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RegistrarCluster
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRegistrarClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRegistrarClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTokensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Tokens []Token `json:"tokens"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Token
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetClusterResponse) StatusCode() int {
+func (r DeleteTokenResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
-	return 0
+	return 0
+}
+
+// ListAuditEventsWithResponse request returning *ListAuditEventsResponse
+func (c *ClientWithResponses) ListAuditEventsWithResponse(ctx context.Context, params *ListAuditEventsParams, reqEditors ...RequestEditorFn) (*ListAuditEventsResponse, error) {
+	rsp, err := c.ListAuditEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAuditEventsResponse(rsp)
+}
+
+// CanIWithResponse request returning *CanIResponse
+func (c *ClientWithResponses) CanIWithResponse(ctx context.Context, params *CanIParams, reqEditors ...RequestEditorFn) (*CanIResponse, error) {
+	rsp, err := c.CanI(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCanIResponse(rsp)
+}
+
+// ListCatalogComponentsWithResponse request returning *ListCatalogComponentsResponse
+func (c *ClientWithResponses) ListCatalogComponentsWithResponse(ctx context.Context, params *ListCatalogComponentsParams, reqEditors ...RequestEditorFn) (*ListCatalogComponentsResponse, error) {
+	rsp, err := c.ListCatalogComponents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCatalogComponentsResponse(rsp)
+}
+
+// AddCatalogComponentWithBodyWithResponse request with arbitrary body returning *AddCatalogComponentResponse
+func (c *ClientWithResponses) AddCatalogComponentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddCatalogComponentResponse, error) {
+	rsp, err := c.AddCatalogComponentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddCatalogComponentResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddCatalogComponentWithResponse(ctx context.Context, body AddCatalogComponentJSONRequestBody, reqEditors ...RequestEditorFn) (*AddCatalogComponentResponse, error) {
+	rsp, err := c.AddCatalogComponent(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddCatalogComponentResponse(rsp)
+}
+
+// DeleteCatalogComponentWithResponse request returning *DeleteCatalogComponentResponse
+func (c *ClientWithResponses) DeleteCatalogComponentWithResponse(ctx context.Context, componentName string, reqEditors ...RequestEditorFn) (*DeleteCatalogComponentResponse, error) {
+	rsp, err := c.DeleteCatalogComponent(ctx, componentName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCatalogComponentResponse(rsp)
+}
+
+// GetCatalogComponentWithResponse request returning *GetCatalogComponentResponse
+func (c *ClientWithResponses) GetCatalogComponentWithResponse(ctx context.Context, componentName string, reqEditors ...RequestEditorFn) (*GetCatalogComponentResponse, error) {
+	rsp, err := c.GetCatalogComponent(ctx, componentName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCatalogComponentResponse(rsp)
+}
+
+// AddCatalogComponentVersionWithBodyWithResponse request with arbitrary body returning *AddCatalogComponentVersionResponse
+func (c *ClientWithResponses) AddCatalogComponentVersionWithBodyWithResponse(ctx context.Context, componentName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddCatalogComponentVersionResponse, error) {
+	rsp, err := c.AddCatalogComponentVersionWithBody(ctx, componentName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddCatalogComponentVersionResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddCatalogComponentVersionWithResponse(ctx context.Context, componentName string, body AddCatalogComponentVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*AddCatalogComponentVersionResponse, error) {
+	rsp, err := c.AddCatalogComponentVersion(ctx, componentName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddCatalogComponentVersionResponse(rsp)
+}
+
+// DeleteCatalogComponentVersionWithResponse request returning *DeleteCatalogComponentVersionResponse
+func (c *ClientWithResponses) DeleteCatalogComponentVersionWithResponse(ctx context.Context, componentName string, componentVersion string, reqEditors ...RequestEditorFn) (*DeleteCatalogComponentVersionResponse, error) {
+	rsp, err := c.DeleteCatalogComponentVersion(ctx, componentName, componentVersion, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCatalogComponentVersionResponse(rsp)
+}
+
+// GetCatalogComponentVersionWithResponse request returning *GetCatalogComponentVersionResponse
+func (c *ClientWithResponses) GetCatalogComponentVersionWithResponse(ctx context.Context, componentName string, componentVersion string, reqEditors ...RequestEditorFn) (*GetCatalogComponentVersionResponse, error) {
+	rsp, err := c.GetCatalogComponentVersion(ctx, componentName, componentVersion, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCatalogComponentVersionResponse(rsp)
+}
+
+// UnsubscribeCatalogComponentVersionWithResponse request returning *UnsubscribeCatalogComponentVersionResponse
+func (c *ClientWithResponses) UnsubscribeCatalogComponentVersionWithResponse(ctx context.Context, componentName string, componentVersion string, params *UnsubscribeCatalogComponentVersionParams, reqEditors ...RequestEditorFn) (*UnsubscribeCatalogComponentVersionResponse, error) {
+	rsp, err := c.UnsubscribeCatalogComponentVersion(ctx, componentName, componentVersion, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnsubscribeCatalogComponentVersionResponse(rsp)
+}
+
+// ListCatalogComponentVersionSubscriptionsWithResponse request returning *ListCatalogComponentVersionSubscriptionsResponse
+func (c *ClientWithResponses) ListCatalogComponentVersionSubscriptionsWithResponse(ctx context.Context, componentName string, componentVersion string, reqEditors ...RequestEditorFn) (*ListCatalogComponentVersionSubscriptionsResponse, error) {
+	rsp, err := c.ListCatalogComponentVersionSubscriptions(ctx, componentName, componentVersion, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCatalogComponentVersionSubscriptionsResponse(rsp)
+}
+
+// SubscribeCatalogComponentVersionWithResponse request returning *SubscribeCatalogComponentVersionResponse
+func (c *ClientWithResponses) SubscribeCatalogComponentVersionWithResponse(ctx context.Context, componentName string, componentVersion string, params *SubscribeCatalogComponentVersionParams, reqEditors ...RequestEditorFn) (*SubscribeCatalogComponentVersionResponse, error) {
+	rsp, err := c.SubscribeCatalogComponentVersion(ctx, componentName, componentVersion, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubscribeCatalogComponentVersionResponse(rsp)
+}
+
+// ListClustersWithResponse request returning *ListClustersResponse
+func (c *ClientWithResponses) ListClustersWithResponse(ctx context.Context, params *ListClustersParams, reqEditors ...RequestEditorFn) (*ListClustersResponse, error) {
+	rsp, err := c.ListClusters(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClustersResponse(rsp)
+}
+
+// CreateClusterWithBodyWithResponse request with arbitrary body returning *CreateClusterResponse
+func (c *ClientWithResponses) CreateClusterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterResponse, error) {
+	rsp, err := c.CreateClusterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClusterResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateClusterWithResponse(ctx context.Context, body CreateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterResponse, error) {
+	rsp, err := c.CreateCluster(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClusterResponse(rsp)
+}
+
+// WatchClustersWithResponse request returning *WatchClustersResponse
+func (c *ClientWithResponses) WatchClustersWithResponse(ctx context.Context, params *WatchClustersParams, reqEditors ...RequestEditorFn) (*WatchClustersResponse, error) {
+	rsp, err := c.WatchClusters(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWatchClustersResponse(rsp)
+}
+
+// DeleteClusterWithResponse request returning *DeleteClusterResponse
+func (c *ClientWithResponses) DeleteClusterWithResponse(ctx context.Context, region string, clusterId string, params *DeleteClusterParams, reqEditors ...RequestEditorFn) (*DeleteClusterResponse, error) {
+	rsp, err := c.DeleteCluster(ctx, region, clusterId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteClusterResponse(rsp)
+}
+
+// GetClusterWithResponse request returning *GetClusterResponse
+func (c *ClientWithResponses) GetClusterWithResponse(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*GetClusterResponse, error) {
+	rsp, err := c.GetCluster(ctx, region, clusterId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClusterResponse(rsp)
+}
+
+// UpdateClusterWithBodyWithResponse request with arbitrary body returning *UpdateClusterResponse
+func (c *ClientWithResponses) UpdateClusterWithBodyWithResponse(ctx context.Context, region string, clusterId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterResponse, error) {
+	rsp, err := c.UpdateClusterWithBody(ctx, region, clusterId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClusterResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateClusterWithResponse(ctx context.Context, region string, clusterId string, body UpdateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterResponse, error) {
+	rsp, err := c.UpdateCluster(ctx, region, clusterId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClusterResponse(rsp)
+}
+
+// GetClusterKubeconfigWithResponse request returning *GetClusterKubeconfigResponse
+func (c *ClientWithResponses) GetClusterKubeconfigWithResponse(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*GetClusterKubeconfigResponse, error) {
+	rsp, err := c.GetClusterKubeconfig(ctx, region, clusterId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClusterKubeconfigResponse(rsp)
+}
+
+// TransferClusterOwnershipWithBodyWithResponse request with arbitrary body returning *TransferClusterOwnershipResponse
+func (c *ClientWithResponses) TransferClusterOwnershipWithBodyWithResponse(ctx context.Context, region string, clusterId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransferClusterOwnershipResponse, error) {
+	rsp, err := c.TransferClusterOwnershipWithBody(ctx, region, clusterId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferClusterOwnershipResponse(rsp)
+}
+
+func (c *ClientWithResponses) TransferClusterOwnershipWithResponse(ctx context.Context, region string, clusterId string, body TransferClusterOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*TransferClusterOwnershipResponse, error) {
+	rsp, err := c.TransferClusterOwnership(ctx, region, clusterId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferClusterOwnershipResponse(rsp)
+}
+
+// ListClusterSubscriptionsWithResponse request returning *ListClusterSubscriptionsResponse
+func (c *ClientWithResponses) ListClusterSubscriptionsWithResponse(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*ListClusterSubscriptionsResponse, error) {
+	rsp, err := c.ListClusterSubscriptions(ctx, region, clusterId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClusterSubscriptionsResponse(rsp)
+}
+
+// ListOperationsWithResponse request returning *ListOperationsResponse
+func (c *ClientWithResponses) ListOperationsWithResponse(ctx context.Context, params *ListOperationsParams, reqEditors ...RequestEditorFn) (*ListOperationsResponse, error) {
+	rsp, err := c.ListOperations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOperationsResponse(rsp)
+}
+
+// GetOperationWithResponse request returning *GetOperationResponse
+func (c *ClientWithResponses) GetOperationWithResponse(ctx context.Context, operationId string, reqEditors ...RequestEditorFn) (*GetOperationResponse, error) {
+	rsp, err := c.GetOperation(ctx, operationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOperationResponse(rsp)
+}
+
+// ListQuotasWithResponse request returning *ListQuotasResponse
+func (c *ClientWithResponses) ListQuotasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListQuotasResponse, error) {
+	rsp, err := c.ListQuotas(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListQuotasResponse(rsp)
+}
+
+// ListRegistrarClustersWithResponse request returning *ListRegistrarClustersResponse
+func (c *ClientWithResponses) ListRegistrarClustersWithResponse(ctx context.Context, params *ListRegistrarClustersParams, reqEditors ...RequestEditorFn) (*ListRegistrarClustersResponse, error) {
+	rsp, err := c.ListRegistrarClusters(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRegistrarClustersResponse(rsp)
+}
+
+// CreateRegistrarClusterWithBodyWithResponse request with arbitrary body returning *CreateRegistrarClusterResponse
+func (c *ClientWithResponses) CreateRegistrarClusterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRegistrarClusterResponse, error) {
+	rsp, err := c.CreateRegistrarClusterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRegistrarClusterResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateRegistrarClusterWithResponse(ctx context.Context, body CreateRegistrarClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRegistrarClusterResponse, error) {
+	rsp, err := c.CreateRegistrarCluster(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRegistrarClusterResponse(rsp)
+}
+
+// DeleteRegistrarClusterWithResponse request returning *DeleteRegistrarClusterResponse
+func (c *ClientWithResponses) DeleteRegistrarClusterWithResponse(ctx context.Context, clusterRegistrarId string, reqEditors ...RequestEditorFn) (*DeleteRegistrarClusterResponse, error) {
+	rsp, err := c.DeleteRegistrarCluster(ctx, clusterRegistrarId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRegistrarClusterResponse(rsp)
 }
 
-type ListClusterSubscriptionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Subscriptions CatalogComponentVersion `json:"subscriptions"`
+// GetRegistrarClusterWithResponse request returning *GetRegistrarClusterResponse
+func (c *ClientWithResponses) GetRegistrarClusterWithResponse(ctx context.Context, clusterRegistrarId string, reqEditors ...RequestEditorFn) (*GetRegistrarClusterResponse, error) {
+	rsp, err := c.GetRegistrarCluster(ctx, clusterRegistrarId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseGetRegistrarClusterResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListClusterSubscriptionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ListTokensWithResponse request returning *ListTokensResponse
+func (c *ClientWithResponses) ListTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTokensResponse, error) {
+	rsp, err := c.ListTokens(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseListTokensResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListClusterSubscriptionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreateTokenWithBodyWithResponse request with arbitrary body returning *CreateTokenResponse
+func (c *ClientWithResponses) CreateTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTokenResponse, error) {
+	rsp, err := c.CreateTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateTokenResponse(rsp)
 }
 
-type ListRegistrarClustersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Clusters []RegistrarCluster `json:"clusters"`
-		Warnings *[]string          `json:"warnings,omitempty"`
+func (c *ClientWithResponses) CreateTokenWithResponse(ctx context.Context, body CreateTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTokenResponse, error) {
+	rsp, err := c.CreateToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseCreateTokenResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListRegistrarClustersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteTokenWithResponse request returning *DeleteTokenResponse
+func (c *ClientWithResponses) DeleteTokenWithResponse(ctx context.Context, tokenId string, reqEditors ...RequestEditorFn) (*DeleteTokenResponse, error) {
+	rsp, err := c.DeleteToken(ctx, tokenId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteTokenResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListRegistrarClustersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseListAuditEventsResponse parses an HTTP response from a ListAuditEventsWithResponse call
+func ParseListAuditEventsResponse(rsp *http.Response) (*ListAuditEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateRegistrarClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *RegistrarCluster
-	JSON400      *Error
-}
+	response := &ListAuditEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Events []AuditEvent `json:"events"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
 
-// Status returns HTTPResponse.Status
-func (r CreateRegistrarClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateRegistrarClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseCanIResponse parses an HTTP response from a CanIWithResponse call
+func ParseCanIResponse(rsp *http.Response) (*CanIResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteRegistrarClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	response := &CanIResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccessReview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteRegistrarClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteRegistrarClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseListCatalogComponentsResponse parses an HTTP response from a ListCatalogComponentsWithResponse call
+func ParseListCatalogComponentsResponse(rsp *http.Response) (*ListCatalogComponentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetRegistrarClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RegistrarCluster
-}
+	response := &ListCatalogComponentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Catalog
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetRegistrarClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetRegistrarClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseAddCatalogComponentResponse parses an HTTP response from a AddCatalogComponentWithResponse call
+func ParseAddCatalogComponentResponse(rsp *http.Response) (*AddCatalogComponentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &AddCatalogComponentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CatalogComponent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ListCatalogComponentsWithResponse request returning *ListCatalogComponentsResponse
-func (c *ClientWithResponses) ListCatalogComponentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCatalogComponentsResponse, error) {
-	rsp, err := c.ListCatalogComponents(ctx, reqEditors...)
+// ParseDeleteCatalogComponentResponse parses an HTTP response from a DeleteCatalogComponentWithResponse call
+func ParseDeleteCatalogComponentResponse(rsp *http.Response) (*DeleteCatalogComponentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListCatalogComponentsResponse(rsp)
+
+	response := &DeleteCatalogComponentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
 }
 
-// AddCatalogComponentWithBodyWithResponse request with arbitrary body returning *AddCatalogComponentResponse
-func (c *ClientWithResponses) AddCatalogComponentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddCatalogComponentResponse, error) {
-	rsp, err := c.AddCatalogComponentWithBody(ctx, contentType, body, reqEditors...)
+// ParseGetCatalogComponentResponse parses an HTTP response from a GetCatalogComponentWithResponse call
+func ParseGetCatalogComponentResponse(rsp *http.Response) (*GetCatalogComponentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddCatalogComponentResponse(rsp)
+
+	response := &GetCatalogComponentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CatalogComponent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) AddCatalogComponentWithResponse(ctx context.Context, body AddCatalogComponentJSONRequestBody, reqEditors ...RequestEditorFn) (*AddCatalogComponentResponse, error) {
-	rsp, err := c.AddCatalogComponent(ctx, body, reqEditors...)
+// ParseAddCatalogComponentVersionResponse parses an HTTP response from a AddCatalogComponentVersionWithResponse call
+func ParseAddCatalogComponentVersionResponse(rsp *http.Response) (*AddCatalogComponentVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddCatalogComponentResponse(rsp)
-}
 
-// DeleteCatalogComponentWithResponse request returning *DeleteCatalogComponentResponse
-func (c *ClientWithResponses) DeleteCatalogComponentWithResponse(ctx context.Context, componentName string, reqEditors ...RequestEditorFn) (*DeleteCatalogComponentResponse, error) {
-	rsp, err := c.DeleteCatalogComponent(ctx, componentName, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AddCatalogComponentVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteCatalogComponentResponse(rsp)
-}
 
-// GetCatalogComponentWithResponse request returning *GetCatalogComponentResponse
-func (c *ClientWithResponses) GetCatalogComponentWithResponse(ctx context.Context, componentName string, reqEditors ...RequestEditorFn) (*GetCatalogComponentResponse, error) {
-	rsp, err := c.GetCatalogComponent(ctx, componentName, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CatalogComponentVersion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
-	return ParseGetCatalogComponentResponse(rsp)
+
+	return response, nil
 }
 
-// AddCatalogComponentVersionWithBodyWithResponse request with arbitrary body returning *AddCatalogComponentVersionResponse
-func (c *ClientWithResponses) AddCatalogComponentVersionWithBodyWithResponse(ctx context.Context, componentName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddCatalogComponentVersionResponse, error) {
-	rsp, err := c.AddCatalogComponentVersionWithBody(ctx, componentName, contentType, body, reqEditors...)
+// ParseDeleteCatalogComponentVersionResponse parses an HTTP response from a DeleteCatalogComponentVersionWithResponse call
+func ParseDeleteCatalogComponentVersionResponse(rsp *http.Response) (*DeleteCatalogComponentVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddCatalogComponentVersionResponse(rsp)
-}
 
-func (c *ClientWithResponses) AddCatalogComponentVersionWithResponse(ctx context.Context, componentName string, body AddCatalogComponentVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*AddCatalogComponentVersionResponse, error) {
-	rsp, err := c.AddCatalogComponentVersion(ctx, componentName, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteCatalogComponentVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAddCatalogComponentVersionResponse(rsp)
-}
 
-// DeleteCatalogComponentVersionWithResponse request returning *DeleteCatalogComponentVersionResponse
-func (c *ClientWithResponses) DeleteCatalogComponentVersionWithResponse(ctx context.Context, componentName string, componentVersion string, reqEditors ...RequestEditorFn) (*DeleteCatalogComponentVersionResponse, error) {
-	rsp, err := c.DeleteCatalogComponentVersion(ctx, componentName, componentVersion, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
-	return ParseDeleteCatalogComponentVersionResponse(rsp)
+
+	return response, nil
 }
 
-// GetCatalogComponentVersionWithResponse request returning *GetCatalogComponentVersionResponse
-func (c *ClientWithResponses) GetCatalogComponentVersionWithResponse(ctx context.Context, componentName string, componentVersion string, reqEditors ...RequestEditorFn) (*GetCatalogComponentVersionResponse, error) {
-	rsp, err := c.GetCatalogComponentVersion(ctx, componentName, componentVersion, reqEditors...)
+// ParseGetCatalogComponentVersionResponse parses an HTTP response from a GetCatalogComponentVersionWithResponse call
+func ParseGetCatalogComponentVersionResponse(rsp *http.Response) (*GetCatalogComponentVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetCatalogComponentVersionResponse(rsp)
-}
 
-// UnsubscribeCatalogComponentVersionWithResponse request returning *UnsubscribeCatalogComponentVersionResponse
-func (c *ClientWithResponses) UnsubscribeCatalogComponentVersionWithResponse(ctx context.Context, componentName string, componentVersion string, params *UnsubscribeCatalogComponentVersionParams, reqEditors ...RequestEditorFn) (*UnsubscribeCatalogComponentVersionResponse, error) {
-	rsp, err := c.UnsubscribeCatalogComponentVersion(ctx, componentName, componentVersion, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetCatalogComponentVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUnsubscribeCatalogComponentVersionResponse(rsp)
-}
 
-// ListCatalogComponentVersionSubscriptionsWithResponse request returning *ListCatalogComponentVersionSubscriptionsResponse
-func (c *ClientWithResponses) ListCatalogComponentVersionSubscriptionsWithResponse(ctx context.Context, componentName string, componentVersion string, reqEditors ...RequestEditorFn) (*ListCatalogComponentVersionSubscriptionsResponse, error) {
-	rsp, err := c.ListCatalogComponentVersionSubscriptions(ctx, componentName, componentVersion, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CatalogComponentVersion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseListCatalogComponentVersionSubscriptionsResponse(rsp)
+
+	return response, nil
 }
 
-// SubscribeCatalogComponentVersionWithResponse request returning *SubscribeCatalogComponentVersionResponse
-func (c *ClientWithResponses) SubscribeCatalogComponentVersionWithResponse(ctx context.Context, componentName string, componentVersion string, params *SubscribeCatalogComponentVersionParams, reqEditors ...RequestEditorFn) (*SubscribeCatalogComponentVersionResponse, error) {
-	rsp, err := c.SubscribeCatalogComponentVersion(ctx, componentName, componentVersion, params, reqEditors...)
+// ParseUnsubscribeCatalogComponentVersionResponse parses an HTTP response from a UnsubscribeCatalogComponentVersionWithResponse call
+func ParseUnsubscribeCatalogComponentVersionResponse(rsp *http.Response) (*UnsubscribeCatalogComponentVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSubscribeCatalogComponentVersionResponse(rsp)
-}
 
-// ListClustersWithResponse request returning *ListClustersResponse
-func (c *ClientWithResponses) ListClustersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClustersResponse, error) {
-	rsp, err := c.ListClusters(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UnsubscribeCatalogComponentVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListClustersResponse(rsp)
-}
 
-// CreateClusterWithBodyWithResponse request with arbitrary body returning *CreateClusterResponse
-func (c *ClientWithResponses) CreateClusterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterResponse, error) {
-	rsp, err := c.CreateClusterWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
-	return ParseCreateClusterResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateClusterWithResponse(ctx context.Context, body CreateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterResponse, error) {
-	rsp, err := c.CreateCluster(ctx, body, reqEditors...)
+// ParseListCatalogComponentVersionSubscriptionsResponse parses an HTTP response from a ListCatalogComponentVersionSubscriptionsWithResponse call
+func ParseListCatalogComponentVersionSubscriptionsResponse(rsp *http.Response) (*ListCatalogComponentVersionSubscriptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateClusterResponse(rsp)
-}
 
-// DeleteClusterWithResponse request returning *DeleteClusterResponse
-func (c *ClientWithResponses) DeleteClusterWithResponse(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*DeleteClusterResponse, error) {
-	rsp, err := c.DeleteCluster(ctx, region, clusterId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListCatalogComponentVersionSubscriptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteClusterResponse(rsp)
-}
 
-// GetClusterWithResponse request returning *GetClusterResponse
-func (c *ClientWithResponses) GetClusterWithResponse(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*GetClusterResponse, error) {
-	rsp, err := c.GetCluster(ctx, region, clusterId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Subscriptions SubscribedClusters `json:"subscriptions"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetClusterResponse(rsp)
+
+	return response, nil
 }
 
-// ListClusterSubscriptionsWithResponse request returning *ListClusterSubscriptionsResponse
-func (c *ClientWithResponses) ListClusterSubscriptionsWithResponse(ctx context.Context, region string, clusterId string, reqEditors ...RequestEditorFn) (*ListClusterSubscriptionsResponse, error) {
-	rsp, err := c.ListClusterSubscriptions(ctx, region, clusterId, reqEditors...)
+// ParseSubscribeCatalogComponentVersionResponse parses an HTTP response from a SubscribeCatalogComponentVersionWithResponse call
+func ParseSubscribeCatalogComponentVersionResponse(rsp *http.Response) (*SubscribeCatalogComponentVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListClusterSubscriptionsResponse(rsp)
-}
 
-// ListRegistrarClustersWithResponse request returning *ListRegistrarClustersResponse
-func (c *ClientWithResponses) ListRegistrarClustersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRegistrarClustersResponse, error) {
-	rsp, err := c.ListRegistrarClusters(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SubscribeCatalogComponentVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListRegistrarClustersResponse(rsp)
-}
 
-// CreateRegistrarClusterWithBodyWithResponse request with arbitrary body returning *CreateRegistrarClusterResponse
-func (c *ClientWithResponses) CreateRegistrarClusterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRegistrarClusterResponse, error) {
-	rsp, err := c.CreateRegistrarClusterWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
-	return ParseCreateRegistrarClusterResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateRegistrarClusterWithResponse(ctx context.Context, body CreateRegistrarClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRegistrarClusterResponse, error) {
-	rsp, err := c.CreateRegistrarCluster(ctx, body, reqEditors...)
+// ParseListClustersResponse parses an HTTP response from a ListClustersWithResponse call
+func ParseListClustersResponse(rsp *http.Response) (*ListClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateRegistrarClusterResponse(rsp)
-}
 
-// DeleteRegistrarClusterWithResponse request returning *DeleteRegistrarClusterResponse
-func (c *ClientWithResponses) DeleteRegistrarClusterWithResponse(ctx context.Context, clusterRegistrarId string, reqEditors ...RequestEditorFn) (*DeleteRegistrarClusterResponse, error) {
-	rsp, err := c.DeleteRegistrarCluster(ctx, clusterRegistrarId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteRegistrarClusterResponse(rsp)
-}
 
-// GetRegistrarClusterWithResponse request returning *GetRegistrarClusterResponse
-func (c *ClientWithResponses) GetRegistrarClusterWithResponse(ctx context.Context, clusterRegistrarId string, reqEditors ...RequestEditorFn) (*GetRegistrarClusterResponse, error) {
-	rsp, err := c.GetRegistrarCluster(ctx, clusterRegistrarId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Clusters []Cluster `json:"clusters"`
+
+			// Continue Token to get the next page, not set on the last page
+			Continue *string   `json:"continue,omitempty"`
+			Warnings *[]string `json:"warnings,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseGetRegistrarClusterResponse(rsp)
+
+	return response, nil
 }
 
-// ParseListCatalogComponentsResponse parses an HTTP response from a ListCatalogComponentsWithResponse call
-func ParseListCatalogComponentsResponse(rsp *http.Response) (*ListCatalogComponentsResponse, error) {
+// ParseCreateClusterResponse parses an HTTP response from a CreateClusterWithResponse call
+func ParseCreateClusterResponse(rsp *http.Response) (*CreateClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListCatalogComponentsResponse{
+	response := &CreateClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Catalog
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Operation
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAddCatalogComponentResponse parses an HTTP response from a AddCatalogComponentWithResponse call
-func ParseAddCatalogComponentResponse(rsp *http.Response) (*AddCatalogComponentResponse, error) {
+// ParseWatchClustersResponse parses an HTTP response from a WatchClustersWithResponse call
+func ParseWatchClustersResponse(rsp *http.Response) (*WatchClustersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddCatalogComponentResponse{
+	response := &WatchClustersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CatalogComponent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2108,60 +4457,74 @@ func ParseAddCatalogComponentResponse(rsp *http.Response) (*AddCatalogComponentR
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteCatalogComponentResponse parses an HTTP response from a DeleteCatalogComponentWithResponse call
-func ParseDeleteCatalogComponentResponse(rsp *http.Response) (*DeleteCatalogComponentResponse, error) {
+// ParseDeleteClusterResponse parses an HTTP response from a DeleteClusterWithResponse call
+func ParseDeleteClusterResponse(rsp *http.Response) (*DeleteClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteCatalogComponentResponse{
+	response := &DeleteClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Operation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Operation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON400 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetCatalogComponentResponse parses an HTTP response from a GetCatalogComponentWithResponse call
-func ParseGetCatalogComponentResponse(rsp *http.Response) (*GetCatalogComponentResponse, error) {
+// ParseGetClusterResponse parses an HTTP response from a GetClusterWithResponse call
+func ParseGetClusterResponse(rsp *http.Response) (*GetClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCatalogComponentResponse{
+	response := &GetClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CatalogComponent
+		var dest Cluster
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2172,26 +4535,26 @@ func ParseGetCatalogComponentResponse(rsp *http.Response) (*GetCatalogComponentR
 	return response, nil
 }
 
-// ParseAddCatalogComponentVersionResponse parses an HTTP response from a AddCatalogComponentVersionWithResponse call
-func ParseAddCatalogComponentVersionResponse(rsp *http.Response) (*AddCatalogComponentVersionResponse, error) {
+// ParseUpdateClusterResponse parses an HTTP response from a UpdateClusterWithResponse call
+func ParseUpdateClusterResponse(rsp *http.Response) (*UpdateClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddCatalogComponentVersionResponse{
+	response := &UpdateClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CatalogComponentVersion
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Operation
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON202 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
@@ -2212,20 +4575,27 @@ func ParseAddCatalogComponentVersionResponse(rsp *http.Response) (*AddCatalogCom
 	return response, nil
 }
 
-// ParseDeleteCatalogComponentVersionResponse parses an HTTP response from a DeleteCatalogComponentVersionWithResponse call
-func ParseDeleteCatalogComponentVersionResponse(rsp *http.Response) (*DeleteCatalogComponentVersionResponse, error) {
+// ParseGetClusterKubeconfigResponse parses an HTTP response from a GetClusterKubeconfigWithResponse call
+func ParseGetClusterKubeconfigResponse(rsp *http.Response) (*GetClusterKubeconfigResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteCatalogComponentVersionResponse{
+	response := &GetClusterKubeconfigResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterKubeconfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2238,67 +4608,83 @@ func ParseDeleteCatalogComponentVersionResponse(rsp *http.Response) (*DeleteCata
 	return response, nil
 }
 
-// ParseGetCatalogComponentVersionResponse parses an HTTP response from a GetCatalogComponentVersionWithResponse call
-func ParseGetCatalogComponentVersionResponse(rsp *http.Response) (*GetCatalogComponentVersionResponse, error) {
+// ParseTransferClusterOwnershipResponse parses an HTTP response from a TransferClusterOwnershipWithResponse call
+func ParseTransferClusterOwnershipResponse(rsp *http.Response) (*TransferClusterOwnershipResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCatalogComponentVersionResponse{
+	response := &TransferClusterOwnershipResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CatalogComponentVersion
+		var dest Cluster
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseUnsubscribeCatalogComponentVersionResponse parses an HTTP response from a UnsubscribeCatalogComponentVersionWithResponse call
-func ParseUnsubscribeCatalogComponentVersionResponse(rsp *http.Response) (*UnsubscribeCatalogComponentVersionResponse, error) {
+// ParseListClusterSubscriptionsResponse parses an HTTP response from a ListClusterSubscriptionsWithResponse call
+func ParseListClusterSubscriptionsResponse(rsp *http.Response) (*ListClusterSubscriptionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UnsubscribeCatalogComponentVersionResponse{
+	response := &ListClusterSubscriptionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Subscriptions CatalogComponentVersion `json:"subscriptions"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON501 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseListCatalogComponentVersionSubscriptionsResponse parses an HTTP response from a ListCatalogComponentVersionSubscriptionsWithResponse call
-func ParseListCatalogComponentVersionSubscriptionsResponse(rsp *http.Response) (*ListCatalogComponentVersionSubscriptionsResponse, error) {
+// ParseListOperationsResponse parses an HTTP response from a ListOperationsWithResponse call
+func ParseListOperationsResponse(rsp *http.Response) (*ListOperationsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListCatalogComponentVersionSubscriptionsResponse{
+	response := &ListOperationsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2306,7 +4692,7 @@ func ParseListCatalogComponentVersionSubscriptionsResponse(rsp *http.Response) (
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Subscriptions SubscribedClusters `json:"subscriptions"`
+			Operations []Operation `json:"operations"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -2318,41 +4704,41 @@ func ParseListCatalogComponentVersionSubscriptionsResponse(rsp *http.Response) (
 	return response, nil
 }
 
-// ParseSubscribeCatalogComponentVersionResponse parses an HTTP response from a SubscribeCatalogComponentVersionWithResponse call
-func ParseSubscribeCatalogComponentVersionResponse(rsp *http.Response) (*SubscribeCatalogComponentVersionResponse, error) {
+// ParseGetOperationResponse parses an HTTP response from a GetOperationWithResponse call
+func ParseGetOperationResponse(rsp *http.Response) (*GetOperationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SubscribeCatalogComponentVersionResponse{
+	response := &GetOperationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Operation
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseListClustersResponse parses an HTTP response from a ListClustersWithResponse call
-func ParseListClustersResponse(rsp *http.Response) (*ListClustersResponse, error) {
+// ParseListQuotasResponse parses an HTTP response from a ListQuotasWithResponse call
+func ParseListQuotasResponse(rsp *http.Response) (*ListQuotasResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListClustersResponse{
+	response := &ListQuotasResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2360,7 +4746,7 @@ func ParseListClustersResponse(rsp *http.Response) (*ListClustersResponse, error
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Clusters []Cluster `json:"clusters"`
+			Quotas   []Quota   `json:"quotas"`
 			Warnings *[]string `json:"warnings,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2373,92 +4759,110 @@ func ParseListClustersResponse(rsp *http.Response) (*ListClustersResponse, error
 	return response, nil
 }
 
-// ParseCreateClusterResponse parses an HTTP response from a CreateClusterWithResponse call
-func ParseCreateClusterResponse(rsp *http.Response) (*CreateClusterResponse, error) {
+// ParseListRegistrarClustersResponse parses an HTTP response from a ListRegistrarClustersWithResponse call
+func ParseListRegistrarClustersResponse(rsp *http.Response) (*ListRegistrarClustersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateClusterResponse{
+	response := &ListRegistrarClustersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Cluster
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Clusters []RegistrarCluster `json:"clusters"`
+
+			// Continue Token to get the next page, not set on the last page
+			Continue *string   `json:"continue,omitempty"`
+			Warnings *[]string `json:"warnings,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteClusterResponse parses an HTTP response from a DeleteClusterWithResponse call
-func ParseDeleteClusterResponse(rsp *http.Response) (*DeleteClusterResponse, error) {
+// ParseCreateRegistrarClusterResponse parses an HTTP response from a CreateRegistrarClusterWithResponse call
+func ParseCreateRegistrarClusterResponse(rsp *http.Response) (*CreateRegistrarClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteClusterResponse{
+	response := &CreateRegistrarClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RegistrarCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetClusterResponse parses an HTTP response from a GetClusterWithResponse call
-func ParseGetClusterResponse(rsp *http.Response) (*GetClusterResponse, error) {
+// ParseDeleteRegistrarClusterResponse parses an HTTP response from a DeleteRegistrarClusterWithResponse call
+func ParseDeleteRegistrarClusterResponse(rsp *http.Response) (*DeleteRegistrarClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClusterResponse{
+	response := &DeleteRegistrarClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Cluster
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseListClusterSubscriptionsResponse parses an HTTP response from a ListClusterSubscriptionsWithResponse call
-func ParseListClusterSubscriptionsResponse(rsp *http.Response) (*ListClusterSubscriptionsResponse, error) {
+// ParseGetRegistrarClusterResponse parses an HTTP response from a GetRegistrarClusterWithResponse call
+func ParseGetRegistrarClusterResponse(rsp *http.Response) (*GetRegistrarClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListClusterSubscriptionsResponse{
+	response := &GetRegistrarClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Subscriptions CatalogComponentVersion `json:"subscriptions"`
-		}
+		var dest RegistrarCluster
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2469,15 +4873,15 @@ func ParseListClusterSubscriptionsResponse(rsp *http.Response) (*ListClusterSubs
 	return response, nil
 }
 
-// ParseListRegistrarClustersResponse parses an HTTP response from a ListRegistrarClustersWithResponse call
-func ParseListRegistrarClustersResponse(rsp *http.Response) (*ListRegistrarClustersResponse, error) {
+// ParseListTokensResponse parses an HTTP response from a ListTokensWithResponse call
+func ParseListTokensResponse(rsp *http.Response) (*ListTokensResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListRegistrarClustersResponse{
+	response := &ListTokensResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2485,8 +4889,7 @@ func ParseListRegistrarClustersResponse(rsp *http.Response) (*ListRegistrarClust
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Clusters []RegistrarCluster `json:"clusters"`
-			Warnings *[]string          `json:"warnings,omitempty"`
+			Tokens []Token `json:"tokens"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -2498,22 +4901,22 @@ func ParseListRegistrarClustersResponse(rsp *http.Response) (*ListRegistrarClust
 	return response, nil
 }
 
-// ParseCreateRegistrarClusterResponse parses an HTTP response from a CreateRegistrarClusterWithResponse call
-func ParseCreateRegistrarClusterResponse(rsp *http.Response) (*CreateRegistrarClusterResponse, error) {
+// ParseCreateTokenResponse parses an HTTP response from a CreateTokenWithResponse call
+func ParseCreateTokenResponse(rsp *http.Response) (*CreateTokenResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateRegistrarClusterResponse{
+	response := &CreateTokenResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest RegistrarCluster
+		var dest Token
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2531,53 +4934,33 @@ func ParseCreateRegistrarClusterResponse(rsp *http.Response) (*CreateRegistrarCl
 	return response, nil
 }
 
-// ParseDeleteRegistrarClusterResponse parses an HTTP response from a DeleteRegistrarClusterWithResponse call
-func ParseDeleteRegistrarClusterResponse(rsp *http.Response) (*DeleteRegistrarClusterResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &DeleteRegistrarClusterResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
-
-// ParseGetRegistrarClusterResponse parses an HTTP response from a GetRegistrarClusterWithResponse call
-func ParseGetRegistrarClusterResponse(rsp *http.Response) (*GetRegistrarClusterResponse, error) {
+// ParseDeleteTokenResponse parses an HTTP response from a DeleteTokenWithResponse call
+func ParseDeleteTokenResponse(rsp *http.Response) (*DeleteTokenResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRegistrarClusterResponse{
+	response := &DeleteTokenResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RegistrarCluster
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
 	return response, nil
 }
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Query the audit log of mutating API calls
+	// (GET /v1/audit)
+	ListAuditEvents(ctx echo.Context, params ListAuditEventsParams) error
+	// Check whether the caller is allowed to perform an action
+	// (GET /v1/auth/can-i)
+	CanI(ctx echo.Context, params CanIParams) error
 	// List all components in the catalog
 	// (GET /v1/catalog)
-	ListCatalogComponents(ctx echo.Context) error
+	ListCatalogComponents(ctx echo.Context, params ListCatalogComponentsParams) error
 	// Add a new component to the catalog
 	// (POST /v1/catalog/components)
 	AddCatalogComponent(ctx echo.Context) error
@@ -2607,22 +4990,43 @@ type ServerInterface interface {
 	SubscribeCatalogComponentVersion(ctx echo.Context, componentName string, componentVersion string, params SubscribeCatalogComponentVersionParams) error
 	// List all clusters
 	// (GET /v1/clusters)
-	ListClusters(ctx echo.Context) error
+	ListClusters(ctx echo.Context, params ListClustersParams) error
 	// Create a new cluster
 	// (POST /v1/clusters)
 	CreateCluster(ctx echo.Context) error
+	// Watch cluster changes
+	// (GET /v1/clusters/watch)
+	WatchClusters(ctx echo.Context, params WatchClustersParams) error
 	// Delete a cluster
 	// (DELETE /v1/clusters/{region}/{clusterId})
-	DeleteCluster(ctx echo.Context, region string, clusterId string) error
+	DeleteCluster(ctx echo.Context, region string, clusterId string, params DeleteClusterParams) error
 	// Get a cluster
 	// (GET /v1/clusters/{region}/{clusterId})
 	GetCluster(ctx echo.Context, region string, clusterId string) error
+	// Update a cluster
+	// (PATCH /v1/clusters/{region}/{clusterId})
+	UpdateCluster(ctx echo.Context, region string, clusterId string) error
+	// Get the admin kubeconfig of a cluster
+	// (GET /v1/clusters/{region}/{clusterId}/kubeconfig)
+	GetClusterKubeconfig(ctx echo.Context, region string, clusterId string) error
+	// Transfer the ownership of a cluster
+	// (PUT /v1/clusters/{region}/{clusterId}/ownership)
+	TransferClusterOwnership(ctx echo.Context, region string, clusterId string) error
 	// List all subscriptions to a cluster
 	// (GET /v1/clusters/{region}/{clusterId}/subscriptions)
 	ListClusterSubscriptions(ctx echo.Context, region string, clusterId string) error
+	// List the operations started by the caller or administered by it
+	// (GET /v1/operations)
+	ListOperations(ctx echo.Context, params ListOperationsParams) error
+	// Get the progress of an operation
+	// (GET /v1/operations/{operationId})
+	GetOperation(ctx echo.Context, operationId string) error
+	// List the quotas applying to the caller teams with their usage
+	// (GET /v1/quotas)
+	ListQuotas(ctx echo.Context) error
 	// List all management clusters
 	// (GET /v1/registrars)
-	ListRegistrarClusters(ctx echo.Context) error
+	ListRegistrarClusters(ctx echo.Context, params ListRegistrarClustersParams) error
 	// Create a new management cluster
 	// (POST /v1/registrars)
 	CreateRegistrarCluster(ctx echo.Context) error
@@ -2632,11 +5036,113 @@ type ServerInterface interface {
 	// Get a management cluster
 	// (GET /v1/registrars/{clusterRegistrarId})
 	GetRegistrarCluster(ctx echo.Context, clusterRegistrarId string) error
+	// List the API tokens created by the caller
+	// (GET /v1/tokens)
+	ListTokens(ctx echo.Context) error
+	// Create a new API token
+	// (POST /v1/tokens)
+	CreateToken(ctx echo.Context) error
+	// Revoke an API token
+	// (DELETE /v1/tokens/{tokenId})
+	DeleteToken(ctx echo.Context, tokenId string) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// ListAuditEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) ListAuditEvents(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditEventsParams
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", ctx.QueryParams(), &params.Actor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actor: %s", err))
+	}
+
+	// ------------- Optional query parameter "resource" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource", ctx.QueryParams(), &params.Resource)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resource: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListAuditEvents(ctx, params)
+	return err
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+// CanI converts echo context to params.
+func (w *ServerInterfaceWrapper) CanI(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CanIParams
+	// ------------- Required query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "action", ctx.QueryParams(), &params.Action)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action: %s", err))
+	}
+
+	// ------------- Required query parameter "resource" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "resource", ctx.QueryParams(), &params.Resource)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resource: %s", err))
+	}
+
+	// ------------- Optional query parameter "region" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "region", ctx.QueryParams(), &params.Region)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter region: %s", err))
+	}
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", ctx.QueryParams(), &params.Id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CanI(ctx, params)
+	return err
 }
 
 // ListCatalogComponents converts echo context to params.
@@ -2647,8 +5153,24 @@ func (w *ServerInterfaceWrapper) ListCatalogComponents(ctx echo.Context) error {
 
 	ctx.Set(BasicAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCatalogComponentsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", ctx.QueryParams(), &params.Continue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter continue: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListCatalogComponents(ctx)
+	err = w.Handler.ListCatalogComponents(ctx, params)
 	return err
 }
 
@@ -2903,10 +5425,33 @@ func (w *ServerInterfaceWrapper) ListClusters(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	ctx.Set(BasicScopes, []string{})
+	ctx.Set(BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListClustersParams
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter labelSelector: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", ctx.QueryParams(), &params.Continue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter continue: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListClusters(ctx)
+	err = w.Handler.ListClusters(ctx, params)
 	return err
 }
 
@@ -2923,6 +5468,42 @@ func (w *ServerInterfaceWrapper) CreateCluster(ctx echo.Context) error {
 	return err
 }
 
+// WatchClusters converts echo context to params.
+func (w *ServerInterfaceWrapper) WatchClusters(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchClustersParams
+	// ------------- Optional query parameter "region" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "region", ctx.QueryParams(), &params.Region)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter region: %s", err))
+	}
+
+	// ------------- Optional query parameter "cluster_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cluster_id", ctx.QueryParams(), &params.ClusterId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cluster_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "resource_version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource_version", ctx.QueryParams(), &params.ResourceVersion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resource_version: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WatchClusters(ctx, params)
+	return err
+}
+
 // DeleteCluster converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteCluster(ctx echo.Context) error {
 	var err error
@@ -2946,8 +5527,24 @@ func (w *ServerInterfaceWrapper) DeleteCluster(ctx echo.Context) error {
 
 	ctx.Set(BasicAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteClusterParams
+	// ------------- Optional query parameter "wait" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wait", ctx.QueryParams(), &params.Wait)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter wait: %s", err))
+	}
+
+	// ------------- Optional query parameter "timeout" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timeout", ctx.QueryParams(), &params.Timeout)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter timeout: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteCluster(ctx, region, clusterId)
+	err = w.Handler.DeleteCluster(ctx, region, clusterId, params)
 	return err
 }
 
@@ -2979,6 +5576,90 @@ func (w *ServerInterfaceWrapper) GetCluster(ctx echo.Context) error {
 	return err
 }
 
+// UpdateCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "region" -------------
+	var region string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "region", ctx.Param("region"), &region, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter region: %s", err))
+	}
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", ctx.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clusterId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateCluster(ctx, region, clusterId)
+	return err
+}
+
+// GetClusterKubeconfig converts echo context to params.
+func (w *ServerInterfaceWrapper) GetClusterKubeconfig(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "region" -------------
+	var region string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "region", ctx.Param("region"), &region, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter region: %s", err))
+	}
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", ctx.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clusterId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetClusterKubeconfig(ctx, region, clusterId)
+	return err
+}
+
+// TransferClusterOwnership converts echo context to params.
+func (w *ServerInterfaceWrapper) TransferClusterOwnership(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "region" -------------
+	var region string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "region", ctx.Param("region"), &region, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter region: %s", err))
+	}
+
+	// ------------- Path parameter "clusterId" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clusterId", ctx.Param("clusterId"), &clusterId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clusterId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TransferClusterOwnership(ctx, region, clusterId)
+	return err
+}
+
 // ListClusterSubscriptions converts echo context to params.
 func (w *ServerInterfaceWrapper) ListClusterSubscriptions(ctx echo.Context) error {
 	var err error
@@ -3007,6 +5688,61 @@ func (w *ServerInterfaceWrapper) ListClusterSubscriptions(ctx echo.Context) erro
 	return err
 }
 
+// ListOperations converts echo context to params.
+func (w *ServerInterfaceWrapper) ListOperations(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOperationsParams
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListOperations(ctx, params)
+	return err
+}
+
+// GetOperation converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOperation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "operationId" -------------
+	var operationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "operationId", ctx.Param("operationId"), &operationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter operationId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOperation(ctx, operationId)
+	return err
+}
+
+// ListQuotas converts echo context to params.
+func (w *ServerInterfaceWrapper) ListQuotas(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListQuotas(ctx)
+	return err
+}
+
 // ListRegistrarClusters converts echo context to params.
 func (w *ServerInterfaceWrapper) ListRegistrarClusters(ctx echo.Context) error {
 	var err error
@@ -3015,8 +5751,24 @@ func (w *ServerInterfaceWrapper) ListRegistrarClusters(ctx echo.Context) error {
 
 	ctx.Set(BasicAuthScopes, []string{"cluster_admin"})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRegistrarClustersParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", ctx.QueryParams(), &params.Continue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter continue: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListRegistrarClusters(ctx)
+	err = w.Handler.ListRegistrarClusters(ctx, params)
 	return err
 }
 
@@ -3073,6 +5825,52 @@ func (w *ServerInterfaceWrapper) GetRegistrarCluster(ctx echo.Context) error {
 	return err
 }
 
+// ListTokens converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTokens(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTokens(ctx)
+	return err
+}
+
+// CreateToken converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateToken(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateToken(ctx)
+	return err
+}
+
+// DeleteToken converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tokenId" -------------
+	var tokenId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tokenId", ctx.Param("tokenId"), &tokenId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tokenId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteToken(ctx, tokenId)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -3101,6 +5899,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/v1/audit", wrapper.ListAuditEvents)
+	router.GET(baseURL+"/v1/auth/can-i", wrapper.CanI)
 	router.GET(baseURL+"/v1/catalog", wrapper.ListCatalogComponents)
 	router.POST(baseURL+"/v1/catalog/components", wrapper.AddCatalogComponent)
 	router.DELETE(baseURL+"/v1/catalog/components/:componentName", wrapper.DeleteCatalogComponent)
@@ -3113,47 +5913,127 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/v1/catalog/components/:componentName/versions/:componentVersion/subscriptions", wrapper.SubscribeCatalogComponentVersion)
 	router.GET(baseURL+"/v1/clusters", wrapper.ListClusters)
 	router.POST(baseURL+"/v1/clusters", wrapper.CreateCluster)
+	router.GET(baseURL+"/v1/clusters/watch", wrapper.WatchClusters)
 	router.DELETE(baseURL+"/v1/clusters/:region/:clusterId", wrapper.DeleteCluster)
 	router.GET(baseURL+"/v1/clusters/:region/:clusterId", wrapper.GetCluster)
+	router.PATCH(baseURL+"/v1/clusters/:region/:clusterId", wrapper.UpdateCluster)
+	router.GET(baseURL+"/v1/clusters/:region/:clusterId/kubeconfig", wrapper.GetClusterKubeconfig)
+	router.PUT(baseURL+"/v1/clusters/:region/:clusterId/ownership", wrapper.TransferClusterOwnership)
 	router.GET(baseURL+"/v1/clusters/:region/:clusterId/subscriptions", wrapper.ListClusterSubscriptions)
+	router.GET(baseURL+"/v1/operations", wrapper.ListOperations)
+	router.GET(baseURL+"/v1/operations/:operationId", wrapper.GetOperation)
+	router.GET(baseURL+"/v1/quotas", wrapper.ListQuotas)
 	router.GET(baseURL+"/v1/registrars", wrapper.ListRegistrarClusters)
 	router.POST(baseURL+"/v1/registrars", wrapper.CreateRegistrarCluster)
 	router.DELETE(baseURL+"/v1/registrars/:clusterRegistrarId", wrapper.DeleteRegistrarCluster)
 	router.GET(baseURL+"/v1/registrars/:clusterRegistrarId", wrapper.GetRegistrarCluster)
+	router.GET(baseURL+"/v1/tokens", wrapper.ListTokens)
+	router.POST(baseURL+"/v1/tokens", wrapper.CreateToken)
+	router.DELETE(baseURL+"/v1/tokens/:tokenId", wrapper.DeleteToken)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaTW/bOBP+KwLf92jETttLc0vbRRF0WyxqtJc0CGhxYrMrkSpJpWsY/u8LivogRerD",
-	"sR2n29xsieIMn3k4z2ioDYp5mnEGTEl0sUEyXkGKi59vscIJX+qfmeAZCEWhuOE+QRWkxY//C7hDF+h/",
-	"0+b+tJxuWs71trqDthOk1hmgC4SFwGu03U6QgB85FUDQxbVt46YeyhffIS6e9ebznCQgY0EzRTnTf8sZ",
-	"pBKULfUMNO64kWAFUt3eg5BdzzKcQvBGli8SKldAbgUsKWcuQN5wF4EJkisu1O2Q57lIgtdLj3ey2UK9",
-	"WFjIjwly/2kfSgzHhOdrA2Y7SpmAGOtZbwlWYVSHAClQH5pkz9AISPk9Trrn7+ZLC+NqYBtSbxVBYJNc",
-	"KhA+kJQEvfo7X0DM2R1dDu3QD83IPoYb8IK3pMIqH84EZgVzM3gX4Epylh40D/bgNK9dctHiLKHMXuCC",
-	"8wQwK4iywhKGvSmnqMaHnPhDCB4IFVSX+w2YYaF5PzhB9eLwGZZUKoHF0yVLb2RDS57nC71XFkDKRQWC",
-	"Glt36o0dHHLbAUCfv55L/fJV+eIvRu8UiHNB1Xqu4TSeLbCk8WWuVrUIF7TUV1E9xUqpTJteABYg/NHF",
-	"5fZwbZCyO26UmykcF2ppgob+5DSO3iRceYkWXbLo8q+rSPEoFoAVRDrqgoECGVXriziLCKSYEf0rE/ye",
-	"EiDWyG8sxQwvIQWm6qfOvun9q6hKtAsfcbJecqmNWbv6Ap2fzc5m2i2eAcMZRRfo5dn52UzvOaxWBWzT",
-	"+/Np3NQpSyjWpkNeJNIropdIpWoLkizoJjPOpMH/xWxWAVSWEzjLqnw8/S4NLQz/RxY7BnoXU+1LxO8i",
-	"q7zZTtCr2SuvZEGfeDMquuM5Iw530MX1xiHC9c124vDo+mZ7M0EyT1Ms1pVtnCSW8YiySK0gimuPJzak",
-	"U7fSy7gMwHtJiFeNmc0AUr3hZH1oXK0i0t12SuSw9eJ6fmT7btTqmxEmeico7gKsg304phmFCbhxxe5x",
-	"QklEWZYrY/X18a1ai08EYLKO4B8qNcd7iYtKdG4xSSlDHo+9+w6tLwmJcMTgp7Vd2qh30nq6qX9/wils",
-	"zTZMwBR5LtHfFdcDXM+wwCkY6bnedGJSqhzVV3UCQ5VyIscH1Kb0xApLW5duPLoHMknjglka6cw5lrO8",
-	"TjqPTp4YM21+AY2/R+SPCWuE7WwreNretkFpeQ/qqfNhdqL0Z5FnLNH2Urf3oJwYjla2dgqY2u/RoyXv",
-	"a/1Wd+roH193q7WeWH4dN7pgLoP5e6txDcJpVbly48HqXG9N60bJggco95PYspPNUNQGLDarOFrVUMVt",
-	"fPVQPXHKKqLy4eTVROXIPlXFM1cfo6LZSVHGFjiBvXDQQqeaf/+CJ5BVp9I03oplyb4c+4XJqkf3TN5O",
-	"o5+LDqNWwLzBq8gMlfUfOYh1Y77udu+zUtN2i67e7WC47NVdkcMri0UVYrKix2arNBsspV6GemdFp4v/",
-	"NDVfe8ld9p6opunVWIgpHvJ/r5zypQ0QDkM0ur9abpa5kz2exWtX8XJPL7xc3MemwIlJ+5DCnS9wUtHZ",
-	"vnaf7Olg2wMP28R2XNB7ooOy4Tf4+bNW7aBVTXJQ/NF0aoTRQ2nUC5+786F8e0h9spf6i2nTZdlSOK4+",
-	"zW2AcMf0RbVrnQF3i1U16KDZOXj8POJLiNDXJj+xYJQt9/mUp+8EuvtYspaKnkPJcpfuls37jiMto+Fs",
-	"/bY4f67wOlKTs4rGIzc1bbPhdGhO38kkEqBywWS0WhOhr1TIjUxGkx33egcF5jwFtaJsaWWbh0z9OpDQ",
-	"yhXv1Kccyh2GPFUzsobbzRfTjRGw7XRTi8qYlmJNyf5qoRa2Dt1+kI516metxQFTD9Dpcd3C0vZgj7Ac",
-	"d6huSNNva/ZCZ1Pt94zW7DGT1UBX7MDRL3thu2xqv6M1VCfs9hL7W5Nnj/fV7l7sf/yltUVeUX292c/N",
-	"9keepy5mvY9On1BVG/gCsS/8/vBRJKg/Lu08N2rdD1Okw9m+wtjD/jgVsh/ixy2Vw/bd8H30Y9ddPvtQ",
-	"n/yLgMHV7HiEvw8lncI5hJWXsWqdrUM1qooO8LdXYwOwDMit5c/hS9+AO0NVcOCRsSXRfjGty+Uw97sq",
-	"518pQrNTJ5z+EvhkoTe1cnAfb7f/BgAA//9RR8zOBzgAAA==",
+	"H4sIAAAAAAAC/+w973PbtpL/CoZ3M9e7YWynbd7cy7fUTjuepmlqOy8fmo4HIlcSahJgAdC2LuP//Wbx",
+	"gwRJUKIs2U5f8qWNRRC72N9YLJafkkyUleDAtUpefkpUtoSSmn++yjJQ6gyuGdzg35UUFUjNwDylRSFu",
+	"IMd/6lUFyctkJkQBlCd3abKQoq7MMKahVMEgpSXjCxzjfqBS0hX+XSuQnJYQGXyXJhL+qplEeL83oIN3",
+	"/mjmE7M/IdM44as8F3yIOHA6K8YQZyVdwKWESiimhVzhqBxUJlmlGc6WnMGCKS1XRAtS1UVB9BIIRVDE",
+	"vEzmUpRJOlyvnVrTxXDOU/OipouUVIxzxheEaaK0qFQwPU5M5gIXjyOyolYaJKmrhaQ5qCTdQDa/8FFa",
+	"qSFm9neSQ1WIFeSEcYOQg50SwYsVORYSTt6eE6aIg0FulsAJF5oo0IRqkkmgZsq0x41MSMgt5P+UME9e",
+	"Jv9x2ErkoRPHQ8vLuzS5EpxDptk106tNL/0cjG0nqGdwWUlxu5oI8y5Grjpn+vU1cB1RjMzSLiLyNNNC",
+	"Rp/koCkr7Pt5znAGWrzrzDumQC1WIOXI9PfRR5ZHh7GyAqkEp24pPXmp9RK4ZhnVkBNUTysKRopx9eSG",
+	"KtLOYbR4AEPUOhPWEgCvSxReVRtrlKTJnLKilpAg0TjryHM7g4TFGBNQJUDpy5H1SVCilhlEH2pmsZoL",
+	"WVKdvExyquGZ+XWT9rlBVgRSLyUBvHbZMQU9ppoWYjEUt675bti7TrDdXMf+SYz7meCa8RqGLL4QV8hQ",
+	"QRagDV853GpS0QWkjcYLy/GCKvtkI3WCVaxZfYvxgAwdHGNim408KKhGabgGqcbeHfFKaVLVs4KpJeSX",
+	"Vt621DC1FFJfbsK8lkX0d4fxVjB7VDcLi+GRJt2/EAdHwyns+VdLzD6XKgmZ8QSXqDojtnA9QQzVN02y",
+	"I2sklOKaFuPzj8tLj8Z+YJ+kg1VECWv9bMTJNP56owdTxvNwLrQBtp2P6ar+e7TnwVQpuYKVIhnl/6XJ",
+	"kl4DoaSSMGe3SWQxaFKkKC6rgnLYaKPs4Hdm7Lg3Qm+eCT5ni43BQDvSaP0Mip1JYWcZp8IBcQw0T8kM",
+	"SMEUOsbZyr4bI9OoteGgb4S82rTQt24Y+tEbDhEn/d66ZWGjMsi7IR3a79nK/KZAXoNMtvOvSlNdb/ZB",
+	"Fty5HYwaCLQcovoTBi5E3JiYuIMm04qUUM48cUvKMYhmOobuZG11FtEtr31xjXIeC24laKil6P8utaRc",
+	"mQGXPnzorvENekl8ZGluKEKyJeULyF2E7Xxq5kHZbUElxTXLQRIJlZBakRaUInMTZkwJVFKLZ13hmEk4",
+	"NoDtO3mDuH3coIk7ghwku4bcb4umIVSCUhg2xAM0qjaKng8bL2SNAH6khcL/v+dXXNzwaMhofxhu92i+",
+	"SslrnleCce3+PKGaKi0kuL9b4+J+CM3Xq2vKCtwWpV3qKCLm5pefaUn/ZMTZR2Lso9tylcC1Mya4q2J6",
+	"SU7aB0KOzOhEk7x6d9rsE7uzuBEf+ebAFZ82tG3ov0Yjfu4Y5a5K3M9g91AKJlmDxq9o/dSSVUMsRgzj",
+	"W7gh5lFKau40sLOVjclq3HC5qYzZAlqmhHICZaVX5JoWNRATYIDVYzNDjA1jKztv5Ly/F/CCMH0v0Ddi",
+	"kWAo99K+abJGLVqzDk5xoqkFCUoRynOC5suQAgXWeh20HRJotkTNIYLHSN/KwaVEvRsC+bAEvQTpsigl",
+	"46R9hyypIjMAThbAQXY3o0FOSPCCcYjni6olVSMBqJCRRb/DhTol7eo7rpplEJpIxvV337YoMa5hAdLa",
+	"wAqDx43cPXPjWn7YbBHiuJZWXdwY0glF2b6NBl+E3pi0Qe6QRIHv7cJDBZccNCj/PslqKYHrYkVkbfJg",
+	"KcnZfI4+3mS/IjBJXssAtc1pMMdOz7s1BuR95cP/3YLvbSighV8J0SLF1FkpFDp/ICXjol02nYlr524t",
+	"0XDMRCvikT1zuYeIKSlYyfQE+bITvGFKB7mVLd+L4xjE/wPCHXdVh/2fEZWaK9BkzqDIFaESyJwVhXd4",
+	"SKkc5rQudOMmmzCvt0sN7V3PrNMSem67GU3wv7041UZF8Qi6VeGBxpeMsxIjmOdx7Q/YNnUT1fIaAyVr",
+	"bC59zOPDJSf4p++SNHkrckBzlaTJG0HzH2hBeQYyEjtt4t8aMaMVc5uMjUsZyGy7pSxAXtr4/57zXDEO",
+	"93sTn+Z1cT/AMcL1fegwh4LxtNwuUTXBOzYCa/A1Xtmkb82G1nhJVK668rm9VkmGRr9ncS30mKF97TPW",
+	"3SWOJbJ789phsXmHmf/tjoGsTF6u8eHGAARQfNRiNvhcEcHT1txoQf73uVHsoabTW6vp/3jx4rsXmzR/",
+	"1JO4jFtjmiKYIUfpAh2FOYna7byoG+IP5O1tm6roh8XmAe4g56xoTGmzqx+YcEdDmzNp9p64VpOYGDhm",
+	"CSpyjHX6jrhnkSiT5tc4hYJ8JMzMQOpLRWPnY69vtaRkKZRG3VO4I2thQeNoAoA4GZubA5Ik3SIjmXN1",
+	"6c02qyKo+D0fnsS5gS0iqXGC7vTOPz0+PTnbCodK5JcZy+W2Ae+Q5nBbiYbgOyqFp8oIZjEj+2sFksZz",
+	"Nk4Ux46HXMrskuqp50DBudwgtaBalXXHWvgnNX8gdRo0I7POGbcJ7m1QGVmVy7dEpOqdFAujN35ISkSR",
+	"g0IllUqH4rPO+zUE/8VOE5OuZlO+3Wmeqgs9cZfrc0QwGd1zMxqRpXIBnWOizaF84FWZ6uydZivMB9js",
+	"WbImF+XjMit1SZo0r+RQQOfQoH3ZjtlGLnqGnzX7YE+uICNquRSITEcnOtD/WKd5v7Qpvq4Crsv97X7+",
+	"6mdfi9u5lxFPf7cbTVJ7Dg25SRVYLY3y4LdaaHrfXZV5+Y0duj6qW0Rl8QwWXv7+wqkIraqCgTI7SrgG",
+	"uXI7HxTLTNRc3zvNdQG0XAsoUICpkGrP/o00em9NSTyH72gdY3RI4DEHMNiY/eP7aComq+oIWYSmRS+J",
+	"cvzuPfH745RQRSgJjMZfNeWa6VU8G15GS5FiUOzYewIK96QxULzG8xZ0UV2gzXvpZordjfHjfdwc3I8d",
+	"a2g4eSc+hn6nbsFjF0xjkWhAxgTQFZFROXrAu+fzzvESBneUE/X85ok9WevlNXoplJRc2WTIJt0e9eRr",
+	"z+HiJOykNoeZBX/qEk2wDAUnB2WBf5qWgHUb6gljnUecNLpHBo+UB5gGy2onjpMnyLANlWpbLYlp7Xk9",
+	"Q3mZQX4c6Oi49jaB4lYB9zqJGaC0ttqlwSVGL1PVFEHuPuH+bcUkqH3E5fZgVm2JwKiyj0fYKhMVbFkj",
+	"oz3JIh58aimvCTWdsjdvtUFmJ7IM6DrKwTPr94aMvA9TRunYUqsfeOGYTLtjPV8oh38s2DVwYqv+XvoE",
+	"LqkokyrFs3oCt7SsCnA1GS993CQk+R//x8FHftHMizsKLh3ANqoiBjd8qkAfmOPd6Rz1O2mamVhtopke",
+	"MsNMldWS6dU5uiNLqxlVLMMC0abOHN8xv7bUX2pdISozoBLkcLT5uT8cATI+F+4MVNPM4G75l7wRLCM/",
+	"FEIPq3hecZOX0L4UJgyUWk/HSQ4lps8E96UPeTDyI7e5Z3Mk79+ypNdMowNKfqHFaiEUAguKSl4mzw+O",
+	"Do6MZlbAacWSl8l3B88PjpI0qaheGrIdXj8/pFhvjH8sIJJj+RVLRBrHEBTc4lukEAsUhxvJtLbySPFg",
+	"BA4SA9bueE5zJBRTuq1sVgYJSUuwBvz3KFgJupYcg33uz1VQMpVhEsNhf9UgV17LXzYFsDZOiYrYFEDc",
+	"Amo06YrxnHzT5BFbjjQ/GbU5ODj47xHEgkLc3XC7WbJsSZa0qoBDTuhcmxQ7U8QZmhh0xXgP9LQ97vb4",
+	"zGAuJGxEqOaaFXtA6Bebywv2Dg4tLRyetizGHHNKyFCJqARyBZUm37j0L3l+dIRMvcV/HI1x0Oz4Yuxr",
+	"w6s/TMaoElxZm/Tt0ZE3Gq6s2GxhbV3m4Z+u0KidsOdUrrequ251a2Os4maOmNaBDUOtNVlDo+32xV6S",
+	"7i5Nvt9yoevWYU9tIqic8mtasJwwXtUG6ouj5w8P9aJj6mwZ5gwzEiAZ5B1vZKxY6Fp+/wMlNvBMv/+B",
+	"MqLqsqQYCCe/oYj1rKmYk7LWVOOeyFRY0aKwp3nWVuvlYUb5MxYY7K6hPab8dJN1fWVCBeOalpBdkW+s",
+	"h0rNCVNKFqDxgKkA/En5SHyNeQsuHHgx07KGrYzdWcfaBqitMbuZrQ2/bDg6zQTvhGWT/qKOiCZ5q0ah",
+	"utTmNmbfqON0GCxfO/+uZmmt1Qmv8UXUxz4n0gwgLp/+RBZjJ1U9NsJ4E55pUywNMGd89sqgubQHEj0Z",
+	"JuCdTnjNzdo7NlG1RUvbv+qwMUoa+j/jK0L354t8Xhwd+bQpjrEVcDhkmDSd5v4mpBvit3tasL50pkL5",
+	"ELVyF320wBivfaxoCSSgQxzD5lLRU+mC4946N9q+lRIhc5D26Nms4LG1wnAUt4CecDaItXh8HzldFy36",
+	"ZC5qvqv3M0ShRRFQpbkC2pCyozyH3ftolVARRXqV5309SprisR+Ezaztk+EtmLu7u75zuRsI3PMHht8v",
+	"ZvMso3lYW+kJ/FTB2/dH/3x4qMHiC5PjJHDLlFYbBDfxYYUp6k0Gcjx43hHrV3lOKOFwE6iLFhPF+vBT",
+	"82+sBryzamhOYweCfmJ+j8j6WpfR0sSlw4wtxYRAaEoDHLaKl4b29ftYgaVHwS4tH7U5AbKiMTqPLjyZ",
+	"uZWGYX+D7wPKj2UroaG1bcqTW7WNBhE/gf7c5eHoicxfIDxTBW0n7/YT6A4PGb+nCTgM7+JOdnn/aorm",
+	"n5r7D+93/Vqf2P120Bgjc1Nh/yV744YIT+uVg2sRO6pm8MBJwT0892ehsumnTVzbALFdxYNFDc3lnMnR",
+	"g3/jKaMIj8OTRxMekV2iiq+y+hgRzVYeZWqAE9GFvQY6fn7GH8CqHrpsdNVcRB2zse95k7j+Krwb09mC",
+	"1C29/HX6tfnsXVbqyg9OT7YA7HL+p/n+PUsgKraVwFCag9BsYyj1XSx3psMscX/JY/A+U5+GqwkopkUM",
+	"/51syvs+gWicRJMz6U5ZzjvW46vz2u2EemCL10lTpKCvfy7dnW+b4+num2sy2OHA/SaxOyjYQpioyMZ3",
+	"8OdffdUWvqo1Dlo8mp+aAHRfPur5UHbPN9nbffqncKl/M9/0yqUUHtY/nYcEoiPTm2g3KFEed1Z+0BY1",
+	"cZ1y+ZLqbGmL6E1tZgGmG6atU2BtKzEXkgd1iGrFNb0dO/PF187dbNuVMHw9mH7kg+kJlfET7zA+drPO",
+	"NLmhkjO+2KXZ5Jry+zWn8e6lzlm8bAp8ODk9efxTedS5RoXTe53SO7e13zP6JmgbC2GOTQmZF6QHyvx7",
+	"MZ2S6f92b2DbG92xTIwjt+9CTZSmUkNuK08bGjV99JhWpHIXn5M0WQLNnaa+EVlzb7x3XYrqpb8UFd7b",
+	"Hrcnd9MCgnRLuR2RunNRgjYeqPX495n6MRKyjl1bnT1srA6zBf7ugKER0m4McHiDbnq00P5cS6BlczXA",
+	"NWlUhCrX2uCZAu5rcA/Ia5ot7R/oMdHV5OTVycnrk5T88uvJ6Y+nr0/QbJy8fvP6An/MqJTM9YTzIKgy",
+	"nVZs9w6S1VIJ8yPLD8gZZK7PB1+0Hs/YcTsQ5VuKemEf+OpKf4m9dYu+kSB2eXxmCpSfnZ4QK/YpvleX",
+	"Di1lKBD0NRVzZ44VKZlSiEmbtHbYmk5MUqG34RqRN0Q4IB/8RQXTbd+1L6HB3eTatms06AmBNc0poeTs",
+	"9fnrC0fXSkIGuUWuNETKCobUJ7kUVTcE00tYEZzQBllMOUgH5MOrs7enb39yjHNmoFnXzVKoYBrblaSg",
+	"2A5fyLbe2IgO5AcOP8SlNy/ydxXcmrRM5URUtgGt77HgmW7vkHRN+AeEslU0avCK3N1s1r/PstgQIGsU",
+	"Zf1e7JLl20E5tnIl5q28oxiYNqOG1BvKiy/bHfEu0Z6GW31o4D2zatE1fQNzn0atSRDlODl59IDG6mkQ",
+	"rzwsWJck2NfhhtGIvkkeWvZPVp7vDj81KYDeAXAfy7ZBZ2OMC+EtLTP6bBJBvbwSKjU+FaYMudFedUA+",
+	"4Is3lOm0qU4ms0JkV2jpNCsinRf9ZAoyCdoan4Xg4C22ZiWIWhN3vbEf0DBlo+Rm24SeQ8XsijvvbvR1",
+	"fSqrybqMJJXulWQZTe50zVQX1D6SSB8o0+YOZshrLcjMt2nNRwwK8jJmRIJGaGO77rx2LNKC3Dj4eJEj",
+	"WyKP/nlkWkm9KFPybYlbHn8PyngMtyt/fjR2EOOk4snKrCcF4460qRNOq2m+qVErw2iWHn2jYIzC57hR",
+	"eByH4OVnNIvoyLQvE95WQLQ5jtEyhy/BRD1oBUO4QY+vZkOdwp6576oTWtZXfhPWOwN2HX77CdLrtvch",
+	"Db9MNeiYi2BQtFNjRm17Q2Nnc6bsv21n3wPiPnull9ReRm2+ZCWBFDDXbXvwmC+1fYO/HEF9sCSSJeRn",
+	"mUqyvVe+UP9QO75MNxCPlDm66LbcQwRsCkm02aS2A/qOBRBWAuh4Mim25TjsdnDa4OWCJk5f/d1utqTz",
+	"OYmB4LRPiflcgTmp6/a4tx9sIs0HDT5v6R808G91YQV6Dx47+kWF0AVP1AfR+UpHPdbeJOSE+0SH//qP",
+	"OblU5isadn+EWNmPApkP4sxx5+5fPiBvTWl/yfhHbu8H26HmOztUKbbg/X0gtXOb/OEMTA5Ai5jjv3Dg",
+	"Bh8g+RoD3FtvWyJOCgMeJU5ucGoETPoa96e5GhKpF7lo77/bFHWjC53M7GMF+RctdCCNzt/HYAxK2jYV",
+	"bWxXUfhF+9EdigfHC+M/iwrCR+xF03wdrdMik+QCFOqhqitzvFQwpX1Xzc7ShkrxQLWPPbVrU9VrterX",
+	"dtgWxVDt5M3pm28tHW2H5Z5tuWNz7br3rAddwmzX73xjWUww+TbK0L6WdlpnuYZPuwtNZ/up/EbXf4XB",
+	"eRYhbSjFUJDsU6YjAnX4KZCju/FGdi08KmEAs1fFkfpNOAZ9TcYWMVMH5EeXSP7IRXdS01cMk/2U3ABc",
+	"xcK4n6AV8o0y7geOeoxw7s9lT7Q2xXERcn7UzrYL32ce0H3hw35yAO0gD1FxcmV6ja83Ur/ZIXs1Ay3Y",
+	"SSbAoBCr1ttDLZ1DZRuD4V7Zk2Wws5l+76twr2zNgjZVMr4ihUlSN43akX/Sd79ez8N+k+yvrZb+zSpa",
+	"+wz+skpbI01jI1Wuf4eeU8OVTPIHTf3P6K3p3vN4ZBuh46YK2IHgPUwGYyjfj9v9Ig6/bzMHvHNNt9uD",
+	"+eUqN19HjZD6yfthbFzNlg0sdhHJTolpjFYD/9ckNhpWDaqSYmU6Efnd4BgHZNmQ3wjw2f9l3gg6m/pE",
+	"RF6ZGnbuxtOmNCEu+2PnN38nDh09tcFZX27wZKy3dQlr9Ng4xvUx7IUdstc4qwU7Kcq68O57bQjjJt0m",
+	"gHGv7GlHYfvw44zeAXVzDaFPH+5XzZuuShKDedGmniBv+lz46x+eGQfE8sckBiqQylRh17wApT5y2n5G",
+	"0X4PwX1VIbUnQaawEps9A9fIOlO2rVZKQ/nSvejee/mxPjr6LkP9Nf+CWNLBOg/LrIcJRzofyHjkUMQJ",
+	"YSTZYBjXhBztdzPMN0BNEEqZKw607P179iYOQ4NG1HuW5PCT+f+kEMALylqvYok75kgcsP37d7+xvRZX",
+	"a1y6HbWv5NGZgYb5opC6d3f/PwCCrgTd4JIAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
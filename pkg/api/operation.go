@@ -0,0 +1,121 @@
+package api
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// OperationTimeout is how long an operation can run before it is reported as failed
+	OperationTimeout     = time.Hour
+	maxOperationMessages = 50
+)
+
+// OperationManager persists the long-running operations started by the cluster mutating calls.
+type OperationManager interface {
+	Create(operation *Operation) (*Operation, error)
+	Get(id string) (*Operation, error)
+	// List returns the operations of owner, or of everyone when owner is empty, most recent first
+	List(owner string, state *OperationState) ([]Operation, error)
+	// Update replaces the stored operation, changes made since it was read are overwritten
+	Update(operation *Operation) error
+	Delete(id string) error
+}
+
+func NewOperation(operationType OperationType, region string, owner string) *Operation {
+	now := time.Now().UTC()
+	return &Operation{
+		Type:      operationType,
+		State:     Running,
+		Region:    region,
+		Owner:     owner,
+		Messages:  []OperationMessage{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// AddMessage records a progress message, it reports false when it repeats the last message
+func (o *Operation) AddMessage(now time.Time, message string) bool {
+	if n := len(o.Messages); n > 0 && o.Messages[n-1].Message == message {
+		return false
+	}
+
+	o.Messages = append(o.Messages, OperationMessage{Time: now, Message: message})
+	if len(o.Messages) > maxOperationMessages {
+		o.Messages = o.Messages[len(o.Messages)-maxOperationMessages:]
+	}
+	o.UpdatedAt = now
+	return true
+}
+
+func (o *Operation) Succeed(now time.Time, result *Cluster) {
+	o.State = Succeeded
+	o.Result = result
+	o.FinishedAt = &now
+	o.AddMessage(now, fmt.Sprintf("%s succeeded", o.Type))
+	o.UpdatedAt = now
+}
+
+func (o *Operation) Fail(now time.Time, err error) {
+	message := err.Error()
+	o.State = Failed
+	o.Error = &message
+	o.FinishedAt = &now
+	o.AddMessage(now, fmt.Sprintf("%s failed", o.Type))
+	o.UpdatedAt = now
+}
+
+// Expire fails a running operation that exceeded OperationTimeout
+func (o *Operation) Expire(now time.Time) bool {
+	if o.State != Running || now.Sub(o.CreatedAt) <= OperationTimeout {
+		return false
+	}
+
+	o.Fail(now, fmt.Errorf("operation timed out after %s", OperationTimeout))
+	return true
+}
+
+// Observe advances a running operation from the current state of its cluster, cluster is nil once
+// deleted. It reports whether the operation changed.
+func (o *Operation) Observe(now time.Time, cluster *Cluster) bool {
+	if o.State != Running {
+		return false
+	}
+
+	if cluster == nil {
+		if o.Type == Delete {
+			o.Succeed(now, nil)
+		} else {
+			o.Fail(now, fmt.Errorf("cluster was deleted"))
+		}
+		return true
+	}
+
+	if o.Type == Delete {
+		changed := o.AddMessage(now, "waiting for the cluster to be removed")
+		return o.Expire(now) || changed
+	}
+
+	if cluster.Status == nil {
+		return o.Expire(now)
+	}
+
+	status := cluster.Status
+	changed := false
+	if status.Phase != "" {
+		changed = o.AddMessage(now, fmt.Sprintf("cluster is %s", status.Phase))
+	}
+
+	done := status.Online && (status.Upgrading == nil || !*status.Upgrading)
+	if o.Type == Update && o.TargetVersion != nil {
+		done = done && status.Version != nil && *status.Version == *o.TargetVersion
+	}
+
+	if done {
+		o.Succeed(now, cluster)
+		return true
+	}
+
+	return o.Expire(now) || changed
+}
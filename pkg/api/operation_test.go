@@ -0,0 +1,60 @@
+package api
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+)
+
+func Test_OperationObserve(t *testing.T) {
+	now := time.Now().UTC()
+	provisioning := &Cluster{Status: &ClusterStatus{Phase: "Provisioning"}}
+	ready := &Cluster{Status: &ClusterStatus{Phase: "Ready", Online: true, Version: ptr.To("v1.29.0")}}
+
+	create := NewOperation(Create, "eu", "alice")
+	assert.True(t, create.Observe(now, provisioning))
+	assert.False(t, create.Observe(now, provisioning), "repeated phases are recorded once")
+	assert.Equal(t, Running, create.State)
+	assert.True(t, create.Observe(now, ready))
+	assert.Equal(t, Succeeded, create.State)
+	assert.Equal(t, ready, create.Result)
+	assert.NotNil(t, create.FinishedAt)
+	assert.False(t, create.Observe(now, nil), "finished operations are left unchanged")
+
+	update := NewOperation(Update, "eu", "alice")
+	update.TargetVersion = ptr.To("v1.30.0")
+	update.Observe(now, ready)
+	assert.Equal(t, Running, update.State, "the cluster still runs the previous version")
+	update.Observe(now, &Cluster{Status: &ClusterStatus{Phase: "Ready", Online: true, Version: ptr.To("v1.30.0")}})
+	assert.Equal(t, Succeeded, update.State)
+
+	deletion := NewOperation(Delete, "eu", "alice")
+	deletion.Observe(now, ready)
+	assert.Equal(t, Running, deletion.State)
+	deletion.Observe(now, nil)
+	assert.Equal(t, Succeeded, deletion.State)
+	assert.Nil(t, deletion.Result)
+
+	vanished := NewOperation(Create, "eu", "alice")
+	vanished.Observe(now, nil)
+	assert.Equal(t, Failed, vanished.State)
+	assert.Equal(t, "cluster was deleted", *vanished.Error)
+
+	stuck := NewOperation(Create, "eu", "alice")
+	assert.True(t, stuck.Observe(now.Add(2*OperationTimeout), provisioning))
+	assert.Equal(t, Failed, stuck.State)
+}
+
+func Test_OperationMessages(t *testing.T) {
+	operation := NewOperation(Create, "eu", "alice")
+	for i := 0; i < 2*maxOperationMessages; i++ {
+		operation.AddMessage(time.Now(), fmt.Sprintf("message %d", i))
+	}
+
+	assert.Len(t, operation.Messages, maxOperationMessages)
+	assert.Equal(t, "message 50", operation.Messages[0].Message)
+	assert.False(t, operation.AddMessage(time.Now(), "message 99"))
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+)
+
+// MaxPageLimit bounds the limit parameter of the list calls
+const MaxPageLimit = 500
+
+// PageOptions restricts a list to a page of items.
+type PageOptions struct {
+	// Limit caps the number of items returned, every item is returned when 0
+	Limit int64
+	// Continue is the token returned with the previous page
+	Continue string
+}
+
+// listCursor is the position of a list spanning the registrars, which are listed in region order.
+// Continue is the Kubernetes continue token to resume Region with, Region is done when it is empty.
+type listCursor struct {
+	Region   string `json:"region"`
+	Continue string `json:"continue,omitempty"`
+}
+
+func (c *listCursor) encode() *string {
+	b, _ := json.Marshal(c)
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return &token
+}
+
+// decodeListCursor parses a continue token of a list spanning the registrars, nil when not set
+func decodeListCursor(token *string) (*listCursor, error) {
+	if token == nil || *token == "" {
+		return nil, nil
+	}
+
+	cursor := &listCursor{}
+	b, err := base64.RawURLEncoding.DecodeString(*token)
+	if err == nil {
+		err = json.Unmarshal(b, cursor)
+	}
+
+	if err != nil || cursor.Region == "" {
+		return nil, errors.NewInvalidArgumentError("invalid continue token")
+	}
+
+	return cursor, nil
+}
+
+// pageLimit validates the limit parameter, 0 when not set
+func pageLimit(limit *int32) (int64, error) {
+	if limit == nil {
+		return 0, nil
+	}
+
+	if *limit <= 0 || *limit > MaxPageLimit {
+		return 0, errors.NewInvalidArgumentError(fmt.Sprintf("limit must be between 1 and %d", MaxPageLimit))
+	}
+
+	return int64(*limit), nil
+}
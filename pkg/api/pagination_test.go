@@ -0,0 +1,41 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func Test_ListCursor(t *testing.T) {
+	cursor, err := decodeListCursor(nil)
+	require.NoError(t, err)
+	assert.Nil(t, cursor)
+
+	token := (&listCursor{Region: "eu-west-1", Continue: "eyJ2IjoibWV0YS5rOHMuaW8vdjEifQ"}).encode()
+	cursor, err = decodeListCursor(token)
+	require.NoError(t, err)
+	assert.Equal(t, &listCursor{Region: "eu-west-1", Continue: "eyJ2IjoibWV0YS5rOHMuaW8vdjEifQ"}, cursor)
+
+	for _, invalid := range []string{"not a token", "e30", "bnVsbA"} {
+		_, err = decodeListCursor(ptr.To(invalid))
+		assert.True(t, errors.IsInvalidArgument(err), invalid)
+	}
+}
+
+func Test_PageLimit(t *testing.T) {
+	limit, err := pageLimit(nil)
+	require.NoError(t, err)
+	assert.Zero(t, limit)
+
+	limit, err = pageLimit(ptr.To[int32](50))
+	require.NoError(t, err)
+	assert.Equal(t, int64(50), limit)
+
+	for _, invalid := range []int32{0, -1, MaxPageLimit + 1} {
+		_, err = pageLimit(ptr.To(invalid))
+		assert.True(t, errors.IsInvalidArgument(err), invalid)
+	}
+}
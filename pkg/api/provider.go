@@ -0,0 +1,106 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+)
+
+const (
+	// ClusterProviderAnnotation names the provider managing the clusters of a registrar.
+	ClusterProviderAnnotation = "malygos.local/cluster-provider"
+	// DefaultClusterProvider manages the clusters of the registrars not naming a provider
+	DefaultClusterProvider = "kamaji"
+)
+
+// ClusterCapabilities describes the cluster options a provider supports, requests setting other
+// options are rejected rather than silently ignored.
+type ClusterCapabilities struct {
+	// Provider names the provider in the errors reported to the API clients
+	Provider string
+	// ControlPlaneReplicas, ControlPlaneResources, ControlPlaneServiceType and Datastore allow the
+	// corresponding control_plane fields
+	ControlPlaneReplicas    bool
+	ControlPlaneResources   bool
+	ControlPlaneServiceType bool
+	Datastore               bool
+	Network                 bool
+	Addons                  bool
+	// Upgrades allows changing the version of existing clusters
+	Upgrades bool
+}
+
+// CheckCluster rejects the options of a cluster to create that the provider doesn't support.
+func (c *ClusterCapabilities) CheckCluster(cluster *Cluster) error {
+	if cluster.ControlPlane != nil {
+		controlPlane := cluster.ControlPlane
+		if controlPlane.Replicas != nil && !c.ControlPlaneReplicas {
+			return c.unsupported("control_plane.replicas")
+		}
+
+		if controlPlane.Resources != nil && !c.ControlPlaneResources {
+			return c.unsupported("control_plane.resources")
+		}
+
+		if controlPlane.ServiceType != nil && !c.ControlPlaneServiceType {
+			return c.unsupported("control_plane.service_type")
+		}
+
+		if controlPlane.Datastore != nil && !c.Datastore {
+			return c.unsupported("control_plane.datastore")
+		}
+	}
+
+	if cluster.Network != nil && !c.Network {
+		return c.unsupported("network")
+	}
+
+	if cluster.Addons != nil && !c.Addons {
+		return c.unsupported("addons")
+	}
+
+	return nil
+}
+
+// supported returns the fields of controlPlane the provider supports, all of them for nil capabilities
+func (c *ClusterCapabilities) supported(controlPlane *ControlPlane) *ControlPlane {
+	if c == nil {
+		return controlPlane
+	}
+
+	filtered := &ControlPlane{}
+	if c.ControlPlaneReplicas {
+		filtered.Replicas = controlPlane.Replicas
+	}
+
+	if c.ControlPlaneResources {
+		filtered.Resources = controlPlane.Resources
+	}
+
+	if c.ControlPlaneServiceType {
+		filtered.ServiceType = controlPlane.ServiceType
+	}
+
+	if c.Datastore {
+		filtered.Datastore = controlPlane.Datastore
+	}
+
+	return filtered
+}
+
+// CheckUpdate rejects the changes the provider doesn't support.
+func (c *ClusterCapabilities) CheckUpdate(update *ClusterUpdate) error {
+	if update.Version != nil && !c.Upgrades {
+		return c.unsupported("version")
+	}
+
+	if update.Addons != nil && !c.Addons {
+		return c.unsupported("addons")
+	}
+
+	return nil
+}
+
+func (c *ClusterCapabilities) unsupported(field string) error {
+	return errors.NewInvalidArgumentError(fmt.Sprintf("%s field is not supported by the %s provider of the region", field, c.Provider))
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+)
+
+func Test_ClusterRegistrar_GetProvider(t *testing.T) {
+	assert.Equal(t, DefaultClusterProvider, (&ClusterRegistrar{}).GetProvider())
+	assert.Equal(t, DefaultClusterProvider, (&ClusterRegistrar{Annotations: map[string]string{ClusterProviderAnnotation: ""}}).GetProvider())
+	assert.Equal(t, "capi", (&ClusterRegistrar{Annotations: map[string]string{ClusterProviderAnnotation: "capi"}}).GetProvider())
+}
+
+func Test_ClusterCapabilities(t *testing.T) {
+	capabilities := &ClusterCapabilities{Provider: "test", ControlPlaneReplicas: true, Upgrades: true}
+
+	assert.NoError(t, capabilities.CheckCluster(&Cluster{Name: "a", Region: "eu", Version: "v1.29.0"}))
+	assert.NoError(t, capabilities.CheckCluster(&Cluster{ControlPlane: &ControlPlane{Replicas: ptr.To(int32(3))}}))
+	assert.NoError(t, capabilities.CheckUpdate(&ClusterUpdate{Version: ptr.To("v1.30.0")}))
+
+	for _, cluster := range []*Cluster{
+		{ControlPlane: &ControlPlane{Resources: &ControlPlaneResources{}}},
+		{ControlPlane: &ControlPlane{ServiceType: ptr.To(LoadBalancer)}},
+		{ControlPlane: &ControlPlane{Datastore: ptr.To("etcd")}},
+		{Network: &Network{}},
+		{Addons: DefaultAddons()},
+	} {
+		err := capabilities.CheckCluster(cluster)
+		assert.True(t, errors.IsInvalidArgument(err), "%+v", cluster)
+	}
+
+	err := capabilities.CheckUpdate(&ClusterUpdate{Addons: DefaultAddons()})
+	assert.True(t, errors.IsInvalidArgument(err))
+	assert.Contains(t, err.Error(), "addons field is not supported by the test provider")
+
+	capabilities.Upgrades = false
+	assert.True(t, errors.IsInvalidArgument(capabilities.CheckUpdate(&ClusterUpdate{Version: ptr.To("v1.30.0")})))
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ResourceUsage is the control plane capacity counted against quotas.
+type ResourceUsage struct {
+	Clusters int64
+	Replicas int64
+	CPU      resource.Quantity
+	Memory   resource.Quantity
+}
+
+func (u *ResourceUsage) Add(other *ResourceUsage) {
+	u.Clusters += other.Clusters
+	u.Replicas += other.Replicas
+	u.CPU.Add(other.CPU)
+	u.Memory.Add(other.Memory)
+}
+
+func (u *ResourceUsage) ToAPI() *QuotaUsage {
+	return &QuotaUsage{
+		Clusters: u.Clusters,
+		Replicas: u.Replicas,
+		Cpu:      u.CPU.String(),
+		Memory:   u.Memory.String(),
+	}
+}
+
+// Applies reports whether the quota counts the clusters of team in region.
+func (q *Quota) Applies(team, region string) bool {
+	if q.Team != nil && *q.Team != "" && *q.Team != team {
+		return false
+	}
+
+	return q.Region == nil || *q.Region == "" || *q.Region == region
+}
+
+// Check returns a QuotaExceededError listing every limit usage goes over.
+func (q *Quota) Check(usage *ResourceUsage) error {
+	exceeded := []string{}
+	if q.Limits.Clusters != nil && usage.Clusters > *q.Limits.Clusters {
+		exceeded = append(exceeded, fmt.Sprintf("clusters %d/%d", usage.Clusters, *q.Limits.Clusters))
+	}
+
+	if q.Limits.Replicas != nil && usage.Replicas > *q.Limits.Replicas {
+		exceeded = append(exceeded, fmt.Sprintf("replicas %d/%d", usage.Replicas, *q.Limits.Replicas))
+	}
+
+	if q.Limits.Cpu != nil {
+		limit, err := resource.ParseQuantity(*q.Limits.Cpu)
+		if err == nil && usage.CPU.Cmp(limit) > 0 {
+			exceeded = append(exceeded, fmt.Sprintf("cpu %s/%s", usage.CPU.String(), limit.String()))
+		}
+	}
+
+	if q.Limits.Memory != nil {
+		limit, err := resource.ParseQuantity(*q.Limits.Memory)
+		if err == nil && usage.Memory.Cmp(limit) > 0 {
+			exceeded = append(exceeded, fmt.Sprintf("memory %s/%s", usage.Memory.String(), limit.String()))
+		}
+	}
+
+	if len(exceeded) > 0 {
+		return errors.NewQuotaExceededError(q.Name, strings.Join(exceeded, ", "))
+	}
+
+	return nil
+}
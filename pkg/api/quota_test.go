@@ -0,0 +1,38 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+func Test_Quota(t *testing.T) {
+	quota := &Quota{
+		Name:   "team-a",
+		Team:   ptr.To("team-a"),
+		Region: ptr.To("eu-west-1"),
+		Limits: QuotaLimits{
+			Clusters: ptr.To(int64(2)),
+			Cpu:      ptr.To("2"),
+		},
+	}
+
+	assert.True(t, quota.Applies("team-a", "eu-west-1"))
+	assert.False(t, quota.Applies("team-b", "eu-west-1"))
+	assert.False(t, quota.Applies("team-a", "us-east-1"))
+	assert.True(t, (&Quota{}).Applies("", "us-east-1"))
+
+	usage := &ResourceUsage{Clusters: 1, Replicas: 3, CPU: resource.MustParse("1500m")}
+	assert.NoError(t, quota.Check(usage))
+
+	usage.Add(&ResourceUsage{Clusters: 1, Replicas: 3, CPU: resource.MustParse("1")})
+	err := quota.Check(usage)
+	assert.True(t, errors.IsQuotaExceeded(err))
+	assert.Equal(t, "quota team-a exceeded: cpu 2500m/2", err.Error())
+
+	usage.Add(&ResourceUsage{Clusters: 1})
+	assert.Equal(t, "quota team-a exceeded: clusters 3/2, cpu 2500m/2", quota.Check(usage).Error())
+}
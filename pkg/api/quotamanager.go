@@ -0,0 +1,6 @@
+package api
+
+type QuotaManager interface {
+	// List returns every quota with its limits, usage is left empty
+	List() ([]Quota, error)
+}
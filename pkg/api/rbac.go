@@ -1,5 +1,7 @@
 package api
 
 type RBAC interface {
-	IsAllowed(username, ressource, action string) bool
+	// IsAllowed reports whether the user may perform action on resource. region and id
+	// scope the check to a region and an object, they are empty when not applicable.
+	IsAllowed(user *UserInfo, action, resource, region, id string) bool
 }
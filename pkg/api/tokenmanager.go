@@ -0,0 +1,11 @@
+package api
+
+type TokenManager interface {
+	Create(owner *UserInfo, request *TokenRequest) (*Token, error)
+	List(owner string) ([]Token, error)
+	Delete(owner string, id string) error
+	// Authenticate returns the identity bound to a token secret
+	Authenticate(token string) (*UserInfo, error)
+	// RefreshGroups updates the groups of the owner tokens, after the owner authenticated with another method
+	RefreshGroups(owner *UserInfo)
+}
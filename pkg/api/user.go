@@ -0,0 +1,89 @@
+package api
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// UsernameContextKey is the echo context key holding the authenticated username.
+	// It is also read by the access logger.
+	UsernameContextKey = "username"
+	// GroupsContextKey is the echo context key holding the authenticated user groups.
+	GroupsContextKey = "groups"
+	// ScopesContextKey is the echo context key holding the scopes of the API token used to authenticate.
+	ScopesContextKey = "scopes"
+	// ImpersonatorContextKey is the echo context key holding the real identity of an impersonated request.
+	ImpersonatorContextKey = "impersonator"
+
+	ImpersonateUserHeader  = "Impersonate-User"
+	ImpersonateGroupHeader = "Impersonate-Group"
+
+	AnonymousUsername = "system:anonymous"
+)
+
+type UserInfo struct {
+	Username string
+	Groups   []string
+	// Scopes restricts the actions allowed to the user, in the action:resource form.
+	// A nil value means the user is not restricted.
+	Scopes []string
+}
+
+// HasScope reports whether the user scopes allow action on resource.
+func (u *UserInfo) HasScope(action, resource string) bool {
+	if u.Scopes == nil {
+		return true
+	}
+
+	for _, scope := range u.Scopes {
+		if scope == "*" {
+			return true
+		}
+
+		scopeAction, scopeResource, _ := strings.Cut(scope, ":")
+		if (scopeAction == "*" || scopeAction == action) && (scopeResource == "*" || scopeResource == resource) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func SetUserInfo(c echo.Context, user *UserInfo) {
+	c.Set(UsernameContextKey, user.Username)
+	c.Set(GroupsContextKey, user.Groups)
+	c.Set(ScopesContextKey, user.Scopes)
+}
+
+// GetUserInfo returns the identity attached to the request by the authentication middleware,
+// or the anonymous user when authentication is disabled.
+func GetUserInfo(c echo.Context) *UserInfo {
+	username, ok := c.Get(UsernameContextKey).(string)
+	if !ok || username == "" {
+		return &UserInfo{Username: AnonymousUsername}
+	}
+
+	groups, _ := c.Get(GroupsContextKey).([]string)
+	scopes, _ := c.Get(ScopesContextKey).([]string)
+	return &UserInfo{
+		Username: username,
+		Groups:   groups,
+		Scopes:   scopes,
+	}
+}
+
+// SetImpersonator replaces the request identity by user, keeping the authenticated identity
+// as the impersonator for logging and auditing.
+func SetImpersonator(c echo.Context, impersonator *UserInfo, user *UserInfo) {
+	c.Set(ImpersonatorContextKey, impersonator)
+	SetUserInfo(c, user)
+}
+
+// GetImpersonator returns the real identity behind an impersonated request, or nil when the
+// request isn't impersonated.
+func GetImpersonator(c echo.Context) *UserInfo {
+	impersonator, _ := c.Get(ImpersonatorContextKey).(*UserInfo)
+	return impersonator
+}
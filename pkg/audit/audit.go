@@ -0,0 +1,170 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+)
+
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+	OutcomeDenied  Outcome = "denied"
+
+	DefaultQueryLimit = 100
+	MaxQueryLimit     = 1000
+)
+
+// Event describes a mutating call made against the API.
+type Event struct {
+	Time      time.Time `json:"time"`
+	RequestID string    `json:"request_id,omitempty"`
+	Actor     string    `json:"actor"`
+	Groups    []string  `json:"groups,omitempty"`
+	// Impersonator is the authenticated user when the actor is impersonated
+	Impersonator string `json:"impersonator,omitempty"`
+	Action       string `json:"action"`
+	Resource     string `json:"resource"`
+	Region       string `json:"region,omitempty"`
+	// ID is the identifier of the object acted upon, such as the cluster ID
+	ID string `json:"id,omitempty"`
+	// Details holds action specific values, such as the subscribed component version
+	Details map[string]string `json:"details,omitempty"`
+	Outcome Outcome           `json:"outcome"`
+	Error   string            `json:"error,omitempty"`
+}
+
+// Filter selects events, empty fields match every event.
+type Filter struct {
+	Actor    string
+	Resource string
+	Since    time.Time
+	Until    time.Time
+	// Limit caps the number of returned events, the most recent ones are kept
+	Limit int
+}
+
+func (f *Filter) Match(event *Event) bool {
+	if f.Actor != "" && f.Actor != event.Actor {
+		return false
+	}
+
+	if f.Resource != "" && f.Resource != event.Resource {
+		return false
+	}
+
+	if !f.Since.IsZero() && event.Time.Before(f.Since) {
+		return false
+	}
+
+	if !f.Until.IsZero() && event.Time.After(f.Until) {
+		return false
+	}
+
+	return true
+}
+
+// Sink persists or forwards audit events.
+type Sink interface {
+	Write(event *Event) error
+}
+
+// Querier is implemented by sinks able to read back the events they stored.
+type Querier interface {
+	Query(filter *Filter) ([]Event, error)
+}
+
+type Config struct {
+	File       string
+	Stdout     bool
+	WebhookURL string
+}
+
+// Auditor dispatches events to every configured sink. Sink failures are logged and never
+// fail the audited request.
+type Auditor struct {
+	logger logr.Logger
+	sinks  []Sink
+}
+
+func NewAuditor(logger logr.Logger, sinks ...Sink) *Auditor {
+	return &Auditor{
+		logger: logger,
+		sinks:  sinks,
+	}
+}
+
+// New builds an auditor from the configuration, it has no sink when nothing is configured.
+func New(ctx context.Context, logger logr.Logger, config Config) (*Auditor, error) {
+	sinks := []Sink{}
+
+	// the file sink goes first as it serves queries
+	if config.File != "" {
+		sink, err := NewFileSink(config.File)
+		if err != nil {
+			return nil, err
+		}
+
+		go func() {
+			<-ctx.Done()
+			sink.Close()
+		}()
+		sinks = append(sinks, sink)
+	}
+
+	if config.Stdout {
+		sinks = append(sinks, NewStdoutSink())
+	}
+
+	if config.WebhookURL != "" {
+		sink, err := NewWebhookSink(logger.WithName("webhook"), config.WebhookURL)
+		if err != nil {
+			return nil, err
+		}
+
+		go sink.Run(ctx)
+		sinks = append(sinks, sink)
+	}
+
+	if len(sinks) == 0 {
+		logger.Info("no audit sink configured, audit events are discarded")
+	}
+
+	return NewAuditor(logger, sinks...), nil
+}
+
+func (a *Auditor) Record(event *Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+
+	for _, sink := range a.sinks {
+		if err := sink.Write(event); err != nil {
+			a.logger.Error(err, "failed to write audit event", "action", event.Action, "resource", event.Resource)
+		}
+	}
+}
+
+// Query reads events back from the first sink supporting it.
+func (a *Auditor) Query(filter *Filter) ([]Event, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultQueryLimit
+	}
+
+	if filter.Limit > MaxQueryLimit {
+		return nil, errors.NewInvalidArgumentError(fmt.Sprintf("limit must be at most %d", MaxQueryLimit))
+	}
+
+	for _, sink := range a.sinks {
+		if querier, ok := sink.(Querier); ok {
+			return querier.Query(filter)
+		}
+	}
+
+	return nil, errors.NewNotImplementedError("no audit sink supports queries")
+}
@@ -0,0 +1,53 @@
+package audit
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Auditor(t *testing.T) {
+	fileSink, err := NewFileSink(filepath.Join(t.TempDir(), "audit.log"))
+	require.NoError(t, err)
+	defer fileSink.Close()
+
+	out := &bytes.Buffer{}
+	auditor := NewAuditor(logr.Discard(), fileSink, NewWriterSink(out))
+
+	start := time.Now().UTC()
+	auditor.Record(&Event{Actor: "alice", Action: "create", Resource: "cluster", Region: "eu-west-1", Outcome: OutcomeSuccess})
+	auditor.Record(&Event{Actor: "bob", Action: "delete", Resource: "cluster", Outcome: OutcomeDenied})
+	auditor.Record(&Event{Actor: "alice", Action: "create", Resource: "token", Outcome: OutcomeFailure, Error: "invalid"})
+	assert.Equal(t, 3, bytes.Count(out.Bytes(), []byte("\n")))
+
+	events, err := auditor.Query(&Filter{})
+	require.NoError(t, err)
+	assert.Len(t, events, 3)
+
+	events, err = auditor.Query(&Filter{Actor: "alice"})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "cluster", events[0].Resource)
+	assert.Equal(t, "eu-west-1", events[0].Region)
+
+	events, err = auditor.Query(&Filter{Resource: "cluster", Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "bob", events[0].Actor, "the most recent events must be kept")
+
+	events, err = auditor.Query(&Filter{Since: start.Add(time.Hour)})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	_, err = auditor.Query(&Filter{Limit: MaxQueryLimit + 1})
+	assert.True(t, errors.IsInvalidArgument(err))
+
+	_, err = NewAuditor(logr.Discard(), NewWriterSink(out)).Query(&Filter{})
+	assert.True(t, errors.IsNotImplemented(err))
+}
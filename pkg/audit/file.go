@@ -0,0 +1,88 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// FileSink appends events as JSON lines to a file, which is scanned to answer queries.
+type FileSink struct {
+	path string
+
+	lock sync.Mutex
+	file *os.File
+}
+
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit file: %v", err)
+	}
+
+	return &FileSink{
+		path: path,
+		file: file,
+	}, nil
+}
+
+func (s *FileSink) Write(event *Event) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, err = s.file.Write(append(b, '\n'))
+	return err
+}
+
+func (s *FileSink) Query(filter *Filter) ([]Event, error) {
+	file, err := os.Open(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit file: %v", err)
+	}
+	defer file.Close()
+
+	events := []Event{}
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			// an incomplete last line is being written
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read audit file: %v", err)
+		}
+
+		event := Event{}
+		if err := json.Unmarshal(line, &event); err != nil {
+			continue
+		}
+
+		if !filter.Match(&event) {
+			continue
+		}
+
+		events = append(events, event)
+		if filter.Limit > 0 && len(events) > filter.Limit {
+			events = events[1:]
+		}
+	}
+
+	return events, nil
+}
+
+func (s *FileSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.file.Close()
+}
@@ -0,0 +1,39 @@
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+)
+
+// WriterSink writes events as JSON lines to a writer, such as the process standard output.
+type WriterSink struct {
+	lock   sync.Mutex
+	writer io.Writer
+}
+
+func NewWriterSink(writer io.Writer) *WriterSink {
+	return &WriterSink{writer: writer}
+}
+
+func NewStdoutSink() *WriterSink {
+	return NewWriterSink(os.Stdout)
+}
+
+func (s *WriterSink) Write(event *Event) error {
+	b, err := json.Marshal(struct {
+		Audit *Event `json:"audit"`
+	}{
+		Audit: event,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, err = s.writer.Write(append(b, '\n'))
+	return err
+}
@@ -0,0 +1,88 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	webhookQueueSize = 1024
+	webhookTimeout   = 10 * time.Second
+)
+
+// WebhookSink posts every event as JSON to an HTTP endpoint. Events are queued so that a slow
+// endpoint doesn't delay API calls, they are dropped when the queue is full.
+type WebhookSink struct {
+	logger     logr.Logger
+	url        string
+	httpClient *http.Client
+	queue      chan Event
+}
+
+func NewWebhookSink(logger logr.Logger, endpoint string) (*WebhookSink, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, fmt.Errorf("audit webhook must be an http(s) URL")
+	}
+
+	return &WebhookSink{
+		logger:     logger,
+		url:        endpoint,
+		httpClient: &http.Client{Timeout: webhookTimeout},
+		queue:      make(chan Event, webhookQueueSize),
+	}, nil
+}
+
+func (s *WebhookSink) Write(event *Event) error {
+	select {
+	case s.queue <- *event:
+		return nil
+	default:
+		return fmt.Errorf("audit webhook queue is full, event dropped")
+	}
+}
+
+func (s *WebhookSink) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-s.queue:
+			if err := s.post(ctx, &event); err != nil {
+				s.logger.Error(err, "failed to send audit event", "action", event.Action, "resource", event.Resource)
+			}
+		}
+	}
+}
+
+func (s *WebhookSink) post(ctx context.Context, event *Event) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("audit webhook answered %s", resp.Status)
+	}
+
+	return nil
+}
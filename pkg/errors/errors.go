@@ -20,6 +20,25 @@ func IsConflict(err error) bool {
 	return ok
 }
 
+// ModifiedError reports an update lost against a concurrent one, the caller can retry it
+type ModifiedError struct {
+	what string
+	kind string
+}
+
+func NewModifiedError(kind string, what string) *ModifiedError {
+	return &ModifiedError{what: what, kind: kind}
+}
+
+func (e *ModifiedError) Error() string {
+	return fmt.Sprintf("conflict: %s %s was modified, retry", e.kind, e.what)
+}
+
+func IsModified(err error) bool {
+	_, ok := err.(*ModifiedError)
+	return ok
+}
+
 type NotFoundError struct {
 	what string
 	kind string
@@ -49,3 +68,78 @@ func NewInvalidArgumentError(what string) *InvalidArgumentError {
 func (e *InvalidArgumentError) Error() string {
 	return fmt.Sprintf("invalid argument: %s", e.what)
 }
+
+func IsInvalidArgument(err error) bool {
+	_, ok := err.(*InvalidArgumentError)
+	return ok
+}
+
+type UnauthorizedError struct {
+	what string
+}
+
+func NewUnauthorizedError(what string) *UnauthorizedError {
+	return &UnauthorizedError{what: what}
+}
+
+func (e *UnauthorizedError) Error() string {
+	return fmt.Sprintf("unauthorized: %s", e.what)
+}
+
+func IsUnauthorized(err error) bool {
+	_, ok := err.(*UnauthorizedError)
+	return ok
+}
+
+type NotImplementedError struct {
+	what string
+}
+
+func NewNotImplementedError(what string) *NotImplementedError {
+	return &NotImplementedError{what: what}
+}
+
+func (e *NotImplementedError) Error() string {
+	return fmt.Sprintf("not implemented: %s", e.what)
+}
+
+func IsNotImplemented(err error) bool {
+	_, ok := err.(*NotImplementedError)
+	return ok
+}
+
+type QuotaExceededError struct {
+	quota string
+	what  string
+}
+
+func NewQuotaExceededError(quota string, what string) *QuotaExceededError {
+	return &QuotaExceededError{quota: quota, what: what}
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("quota %s exceeded: %s", e.quota, e.what)
+}
+
+func IsQuotaExceeded(err error) bool {
+	_, ok := err.(*QuotaExceededError)
+	return ok
+}
+
+type NotReadyError struct {
+	kind string
+	what string
+}
+
+func NewNotReadyError(kind string, what string) *NotReadyError {
+	return &NotReadyError{kind: kind, what: what}
+}
+
+func (e *NotReadyError) Error() string {
+	return fmt.Sprintf("%s %s is not ready", e.kind, e.what)
+}
+
+func IsNotReady(err error) bool {
+	_, ok := err.(*NotReadyError)
+	return ok
+}
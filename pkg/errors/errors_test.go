@@ -24,3 +24,57 @@ func Test_ConflictError(t *testing.T) {
 	assert.False(t, IsConflict(fmt.Errorf("test")))
 	assert.Equal(t, "conflict: thing test already exists", err.Error())
 }
+
+func Test_ModifiedError(t *testing.T) {
+	err := NewModifiedError("thing", "test")
+	assert.True(t, IsModified(err))
+	assert.False(t, IsConflict(err))
+	assert.False(t, IsModified(nil))
+	assert.False(t, IsModified(fmt.Errorf("test")))
+	assert.Equal(t, "conflict: thing test was modified, retry", err.Error())
+}
+
+func Test_UnauthorizedError(t *testing.T) {
+	err := NewUnauthorizedError("token expired")
+	assert.True(t, IsUnauthorized(err))
+	assert.False(t, IsNotFound(err))
+	assert.False(t, IsUnauthorized(nil))
+	assert.False(t, IsUnauthorized(fmt.Errorf("test")))
+	assert.Equal(t, "unauthorized: token expired", err.Error())
+}
+
+func Test_InvalidArgumentError(t *testing.T) {
+	err := NewInvalidArgumentError("name field is required")
+	assert.True(t, IsInvalidArgument(err))
+	assert.False(t, IsConflict(err))
+	assert.False(t, IsInvalidArgument(nil))
+	assert.False(t, IsInvalidArgument(fmt.Errorf("test")))
+	assert.Equal(t, "invalid argument: name field is required", err.Error())
+}
+
+func Test_NotImplementedError(t *testing.T) {
+	err := NewNotImplementedError("queries")
+	assert.True(t, IsNotImplemented(err))
+	assert.False(t, IsNotFound(err))
+	assert.False(t, IsNotImplemented(nil))
+	assert.False(t, IsNotImplemented(fmt.Errorf("test")))
+	assert.Equal(t, "not implemented: queries", err.Error())
+}
+
+func Test_QuotaExceededError(t *testing.T) {
+	err := NewQuotaExceededError("team-a", "clusters 5/5")
+	assert.True(t, IsQuotaExceeded(err))
+	assert.False(t, IsConflict(err))
+	assert.False(t, IsQuotaExceeded(nil))
+	assert.False(t, IsQuotaExceeded(fmt.Errorf("test")))
+	assert.Equal(t, "quota team-a exceeded: clusters 5/5", err.Error())
+}
+
+func Test_NotReadyError(t *testing.T) {
+	err := NewNotReadyError("cluster", "test")
+	assert.True(t, IsNotReady(err))
+	assert.False(t, IsNotFound(err))
+	assert.False(t, IsNotReady(nil))
+	assert.False(t, IsNotReady(fmt.Errorf("test")))
+	assert.Equal(t, "cluster test is not ready", err.Error())
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+)
+
+// Authenticator extracts and validates the caller identity from a request.
+// It returns a nil UserInfo and a nil error when the request doesn't carry
+// credentials it understands, so that the next authenticator can be tried.
+type Authenticator interface {
+	Authenticate(c echo.Context) (*api.UserInfo, error)
+}
+
+// Middleware authenticates every request against the given authenticators and
+// stores the resulting identity on the echo context.
+func Middleware(logger logr.Logger, skipper middleware.Skipper, authenticators ...Authenticator) echo.MiddlewareFunc {
+	if skipper == nil {
+		skipper = middleware.DefaultSkipper
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if skipper(c) {
+				return next(c)
+			}
+
+			for _, authenticator := range authenticators {
+				user, err := authenticator.Authenticate(c)
+				if err != nil {
+					if !errors.IsUnauthorized(err) {
+						logger.Error(err, "failed to authenticate request")
+					}
+
+					return c.JSON(http.StatusUnauthorized, api.Error{Error: "invalid credentials"})
+				}
+
+				if user != nil {
+					api.SetUserInfo(c, user)
+					return next(c)
+				}
+			}
+
+			return c.JSON(http.StatusUnauthorized, api.Error{Error: "authentication required"})
+		}
+	}
+}
+
+// DefaultSkipper skips authentication for CORS preflight requests and the metrics endpoint.
+func DefaultSkipper(c echo.Context) bool {
+	return c.Request().Method == http.MethodOptions || c.Path() == "/metrics"
+}
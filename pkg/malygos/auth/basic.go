@@ -0,0 +1,246 @@
+package auth
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/labstack/echo/v4"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/nrz-incubator/malygos/pkg/util"
+	"golang.org/x/crypto/bcrypt"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const DefaultHtpasswdSecretKey = "htpasswd"
+
+// HtpasswdSource provides the content of an htpasswd file along with a revision
+// which changes whenever the content does.
+type HtpasswdSource interface {
+	Revision(ctx context.Context) (string, error)
+	Read(ctx context.Context) ([]byte, string, error)
+}
+
+type htpasswdFileSource struct {
+	path string
+}
+
+func NewHtpasswdFileSource(path string) HtpasswdSource {
+	return &htpasswdFileSource{path: path}
+}
+
+func (s *htpasswdFileSource) Revision(_ context.Context) (string, error) {
+	return util.FileRevision(s.path)
+}
+
+func (s *htpasswdFileSource) Read(ctx context.Context) ([]byte, string, error) {
+	revision, err := s.Revision(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	b, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return b, revision, nil
+}
+
+type htpasswdSecretSource struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+	key       string
+}
+
+func NewHtpasswdSecretSource(client kubernetes.Interface, namespace string, name string, key string) HtpasswdSource {
+	if key == "" {
+		key = DefaultHtpasswdSecretKey
+	}
+
+	return &htpasswdSecretSource{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+		key:       key,
+	}
+}
+
+func (s *htpasswdSecretSource) Revision(ctx context.Context) (string, error) {
+	_, revision, err := s.Read(ctx)
+	return revision, err
+}
+
+func (s *htpasswdSecretSource) Read(ctx context.Context) ([]byte, string, error) {
+	secret, err := s.client.CoreV1().Secrets(s.namespace).Get(ctx, s.name, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, "", errors.NewNotFoundError("secret", s.name)
+		}
+
+		return nil, "", fmt.Errorf("failed to get secret %s: %v", s.name, err)
+	}
+
+	b, ok := secret.Data[s.key]
+	if !ok {
+		return nil, "", fmt.Errorf("secret %s has no %s key", s.name, s.key)
+	}
+
+	return b, secret.ResourceVersion, nil
+}
+
+// dummyHash is compared against for unknown users, so that the response time doesn't disclose
+// which users exist
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("malygos"), bcrypt.DefaultCost)
+
+type htpasswdEntry struct {
+	hash   []byte
+	groups []string
+}
+
+// BasicAuthenticator checks basic auth credentials against bcrypt hashes from an htpasswd source.
+// Lines are in the form user:hash[:group1,group2], the optional third field maps the user to groups.
+type BasicAuthenticator struct {
+	logger logr.Logger
+	source HtpasswdSource
+
+	lock     sync.RWMutex
+	users    map[string]htpasswdEntry
+	revision string
+}
+
+func NewBasicAuthenticator(logger logr.Logger, source HtpasswdSource) (*BasicAuthenticator, error) {
+	a := &BasicAuthenticator{
+		logger: logger,
+		source: source,
+	}
+
+	if err := a.reload(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (a *BasicAuthenticator) Authenticate(c echo.Context) (*api.UserInfo, error) {
+	username, password, ok := c.Request().BasicAuth()
+	if !ok {
+		return nil, nil
+	}
+
+	a.lock.RLock()
+	entry, found := a.users[username]
+	a.lock.RUnlock()
+
+	if !found {
+		_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return nil, errors.NewUnauthorizedError(fmt.Sprintf("unknown user %s", username))
+	}
+
+	if err := bcrypt.CompareHashAndPassword(entry.hash, []byte(password)); err != nil {
+		return nil, errors.NewUnauthorizedError(fmt.Sprintf("invalid password for user %s", username))
+	}
+
+	return &api.UserInfo{
+		Username: username,
+		Groups:   entry.groups,
+	}, nil
+}
+
+// Run reloads the htpasswd content whenever its revision changes, until the context is done.
+func (a *BasicAuthenticator) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			revision, err := a.source.Revision(ctx)
+			if err != nil {
+				a.logger.Error(err, "failed to check htpasswd revision")
+				continue
+			}
+
+			a.lock.RLock()
+			changed := revision != a.revision
+			a.lock.RUnlock()
+
+			if !changed {
+				continue
+			}
+
+			if err := a.reload(ctx); err != nil {
+				a.logger.Error(err, "failed to reload htpasswd, keeping previous users")
+				continue
+			}
+
+			a.logger.Info("htpasswd reloaded")
+		}
+	}
+}
+
+func (a *BasicAuthenticator) reload(ctx context.Context) error {
+	b, revision, err := a.source.Read(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read htpasswd: %v", err)
+	}
+
+	users, err := parseHtpasswd(b)
+	if err != nil {
+		return err
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.users = users
+	a.revision = revision
+	return nil
+}
+
+func parseHtpasswd(b []byte) (map[string]htpasswdEntry, error) {
+	users := map[string]htpasswdEntry{}
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, ":")
+		if len(fields) < 2 || len(fields) > 3 || fields[0] == "" {
+			return nil, fmt.Errorf("htpasswd line %d is malformed", lineNumber)
+		}
+
+		if _, err := bcrypt.Cost([]byte(fields[1])); err != nil {
+			return nil, fmt.Errorf("htpasswd line %d: only bcrypt hashes are supported", lineNumber)
+		}
+
+		entry := htpasswdEntry{hash: []byte(fields[1])}
+		if len(fields) == 3 {
+			for _, group := range strings.Split(fields[2], ",") {
+				if group = strings.TrimSpace(group); group != "" {
+					entry.groups = append(entry.groups, group)
+				}
+			}
+		}
+
+		users[fields[0]] = entry
+	}
+
+	return users, scanner.Err()
+}
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/labstack/echo/v4"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func basicAuthRequest(username, password string) echo.Context {
+	req := httptest.NewRequest(http.MethodGet, "/v1/clusters", nil)
+	req.SetBasicAuth(username, password)
+	return echo.New().NewContext(req, httptest.NewRecorder())
+}
+
+func Test_BasicAuthenticator(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "htpasswd")
+	content := "# comment\nalice:" + string(hash) + ":admins, ops\nbob:" + string(hash) + "\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	authenticator, err := NewBasicAuthenticator(logr.Discard(), NewHtpasswdFileSource(path))
+	require.NoError(t, err)
+
+	user, err := authenticator.Authenticate(basicAuthRequest("alice", "secret"))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", user.Username)
+	assert.Equal(t, []string{"admins", "ops"}, user.Groups)
+
+	user, err = authenticator.Authenticate(basicAuthRequest("bob", "secret"))
+	require.NoError(t, err)
+	assert.Empty(t, user.Groups)
+
+	_, err = authenticator.Authenticate(basicAuthRequest("alice", "wrong"))
+	assert.True(t, errors.IsUnauthorized(err))
+
+	_, err = authenticator.Authenticate(basicAuthRequest("carol", "secret"))
+	assert.True(t, errors.IsUnauthorized(err))
+
+	// unknown users go through a bcrypt comparison as well
+	cost, err := bcrypt.Cost(dummyHash)
+	require.NoError(t, err)
+	assert.Equal(t, bcrypt.DefaultCost, cost)
+}
+
+func Test_ParseHtpasswd(t *testing.T) {
+	_, err := parseHtpasswd([]byte("alice:{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g="))
+	assert.Error(t, err)
+
+	_, err = parseHtpasswd([]byte("alice"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+)
+
+// ClientCertificateAuthenticator maps client certificates verified during the TLS handshake to
+// users: the subject common name is the username and the organizations are the groups.
+type ClientCertificateAuthenticator struct{}
+
+func NewClientCertificateAuthenticator() *ClientCertificateAuthenticator {
+	return &ClientCertificateAuthenticator{}
+}
+
+func (a *ClientCertificateAuthenticator) Authenticate(c echo.Context) (*api.UserInfo, error) {
+	state := c.Request().TLS
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil, nil
+	}
+
+	subject := state.VerifiedChains[0][0].Subject
+	if subject.CommonName == "" {
+		return nil, errors.NewUnauthorizedError("client certificate has no common name")
+	}
+
+	return &api.UserInfo{
+		Username: subject.CommonName,
+		Groups:   subject.Organization,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func clientCertificateRequest(subject *pkix.Name) echo.Context {
+	req := httptest.NewRequest(http.MethodGet, "/v1/clusters", nil)
+	if subject != nil {
+		req.TLS = &tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{{Subject: *subject}}},
+		}
+	}
+
+	return echo.New().NewContext(req, httptest.NewRecorder())
+}
+
+func Test_ClientCertificateAuthenticator(t *testing.T) {
+	authenticator := NewClientCertificateAuthenticator()
+
+	user, err := authenticator.Authenticate(clientCertificateRequest(&pkix.Name{
+		CommonName:   "ci-runner",
+		Organization: []string{"ops", "deployers"},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "ci-runner", user.Username)
+	assert.Equal(t, []string{"ops", "deployers"}, user.Groups)
+
+	user, err = authenticator.Authenticate(clientCertificateRequest(nil))
+	assert.NoError(t, err)
+	assert.Nil(t, user)
+
+	_, err = authenticator.Authenticate(clientCertificateRequest(&pkix.Name{Organization: []string{"ops"}}))
+	assert.True(t, errors.IsUnauthorized(err))
+}
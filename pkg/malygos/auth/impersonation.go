@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/nrz-incubator/malygos/pkg/api"
+)
+
+// ImpersonationMiddleware lets authorized callers act as another user with the Impersonate-User
+// and Impersonate-Group headers, as on the Kubernetes API server. The caller needs the impersonate
+// action on the user resource for the username and on the group resource for each group.
+// It must run after the authentication middleware.
+func ImpersonationMiddleware(logger logr.Logger, skipper middleware.Skipper, rbac api.RBAC) echo.MiddlewareFunc {
+	if skipper == nil {
+		skipper = middleware.DefaultSkipper
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Request().Header
+			username := header.Get(api.ImpersonateUserHeader)
+			groups := header.Values(api.ImpersonateGroupHeader)
+			if skipper(c) || (username == "" && len(groups) == 0) {
+				return next(c)
+			}
+
+			if username == "" {
+				return c.JSON(http.StatusBadRequest, api.Error{Error: "Impersonate-Group requires Impersonate-User"})
+			}
+
+			impersonator := api.GetUserInfo(c)
+			if impersonator.Username == api.AnonymousUsername {
+				return c.JSON(http.StatusUnauthorized, api.Error{Error: "impersonation requires an authenticated user"})
+			}
+
+			if !canImpersonate(rbac, impersonator, "user", username) {
+				logger.Info("impersonation denied", "username", impersonator.Username, "impersonatedUser", username)
+				return c.JSON(http.StatusForbidden, api.Error{Error: "impersonation of user " + username + " is not allowed"})
+			}
+
+			for _, group := range groups {
+				if !canImpersonate(rbac, impersonator, "group", group) {
+					logger.Info("impersonation denied", "username", impersonator.Username, "impersonatedGroup", group)
+					return c.JSON(http.StatusForbidden, api.Error{Error: "impersonation of group " + group + " is not allowed"})
+				}
+			}
+
+			logger.Info("impersonating user", "username", impersonator.Username,
+				"impersonatedUser", username, "impersonatedGroups", groups)
+
+			// token scopes still apply to the impersonated identity
+			api.SetImpersonator(c, impersonator, &api.UserInfo{
+				Username: username,
+				Groups:   groups,
+				Scopes:   impersonator.Scopes,
+			})
+
+			return next(c)
+		}
+	}
+}
+
+func canImpersonate(rbac api.RBAC, impersonator *api.UserInfo, resource, name string) bool {
+	return impersonator.HasScope("impersonate", resource) && rbac.IsAllowed(impersonator, "impersonate", resource, "", name)
+}
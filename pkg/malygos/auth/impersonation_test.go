@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/labstack/echo/v4"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// supportRBAC only lets the support user impersonate alice and the ops group
+type supportRBAC struct{}
+
+func (supportRBAC) IsAllowed(user *api.UserInfo, action, resource, _, id string) bool {
+	return user.Username == "support" && action == "impersonate" &&
+		((resource == "user" && id == "alice") || (resource == "group" && id == "ops"))
+}
+
+func Test_ImpersonationMiddleware(t *testing.T) {
+	var effective, impersonator *api.UserInfo
+	handler := ImpersonationMiddleware(logr.Discard(), nil, supportRBAC{})(func(c echo.Context) error {
+		effective = api.GetUserInfo(c)
+		impersonator = api.GetImpersonator(c)
+		return c.NoContent(http.StatusOK)
+	})
+
+	serve := func(username string, headers map[string][]string) int {
+		effective, impersonator = nil, nil
+		req := httptest.NewRequest(http.MethodGet, "/v1/clusters", nil)
+		for key, values := range headers {
+			for _, value := range values {
+				req.Header.Add(key, value)
+			}
+		}
+
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		if username != "" {
+			api.SetUserInfo(c, &api.UserInfo{Username: username})
+		}
+
+		assert.NoError(t, handler(c))
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusOK, serve("support", map[string][]string{
+		api.ImpersonateUserHeader:  {"alice"},
+		api.ImpersonateGroupHeader: {"ops"},
+	}))
+	assert.Equal(t, "alice", effective.Username)
+	assert.Equal(t, []string{"ops"}, effective.Groups)
+	assert.Equal(t, "support", impersonator.Username)
+
+	assert.Equal(t, http.StatusOK, serve("support", nil))
+	assert.Equal(t, "support", effective.Username)
+	assert.Nil(t, impersonator)
+
+	assert.Equal(t, http.StatusForbidden, serve("support", map[string][]string{
+		api.ImpersonateUserHeader:  {"alice"},
+		api.ImpersonateGroupHeader: {"admins"},
+	}))
+	assert.Equal(t, http.StatusForbidden, serve("bob", map[string][]string{api.ImpersonateUserHeader: {"alice"}}))
+	assert.Equal(t, http.StatusBadRequest, serve("support", map[string][]string{api.ImpersonateGroupHeader: {"ops"}}))
+	assert.Equal(t, http.StatusUnauthorized, serve("", map[string][]string{api.ImpersonateUserHeader: {"alice"}}))
+}
@@ -0,0 +1,364 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+)
+
+const (
+	jwksRefreshInterval = time.Minute
+	jwksFetchTimeout    = 10 * time.Second
+	// maxJWKSSize bounds the key sets read from the identity provider
+	maxJWKSSize = 1 << 20
+)
+
+type JWTConfig struct {
+	Issuer   string
+	Audience string
+	// JWKS is either a local file path or an http(s) URL
+	JWKS          string
+	UsernameClaim string
+	GroupsClaim   string
+}
+
+type JWTAuthenticator struct {
+	config     JWTConfig
+	httpClient *http.Client
+
+	// refreshLock serializes the key set refreshes, so that a single one is in flight
+	refreshLock sync.Mutex
+	lock        sync.RWMutex
+	keys        map[string]crypto.PublicKey
+	// lastRefresh is the time of the last refresh attempt, failed ones included
+	lastRefresh time.Time
+}
+
+func NewJWTAuthenticator(config JWTConfig) (*JWTAuthenticator, error) {
+	if config.Issuer == "" {
+		return nil, fmt.Errorf("JWT issuer is required")
+	}
+
+	if config.Audience == "" {
+		return nil, fmt.Errorf("JWT audience is required")
+	}
+
+	if config.UsernameClaim == "" {
+		config.UsernameClaim = "sub"
+	}
+
+	if config.GroupsClaim == "" {
+		config.GroupsClaim = "groups"
+	}
+
+	a := &JWTAuthenticator{
+		config:     config,
+		httpClient: &http.Client{Timeout: jwksFetchTimeout},
+	}
+
+	if err := a.refreshKeys(); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (a *JWTAuthenticator) Authenticate(c echo.Context) (*api.UserInfo, error) {
+	token, ok := bearerToken(c)
+	if !ok {
+		return nil, nil
+	}
+
+	claims := jwt.MapClaims{}
+	parser := &jwt.Parser{
+		ValidMethods: []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"},
+	}
+
+	if _, err := parser.ParseWithClaims(token, claims, a.keyFunc); err != nil {
+		return nil, errors.NewUnauthorizedError(err.Error())
+	}
+
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.NewUnauthorizedError("token is expired or has no expiry")
+	}
+
+	if !claims.VerifyIssuer(a.config.Issuer, true) {
+		return nil, errors.NewUnauthorizedError("invalid token issuer")
+	}
+
+	if !claims.VerifyAudience(a.config.Audience, true) {
+		return nil, errors.NewUnauthorizedError("invalid token audience")
+	}
+
+	username, _ := claims[a.config.UsernameClaim].(string)
+	if username == "" {
+		return nil, errors.NewUnauthorizedError(fmt.Sprintf("claim %s is missing", a.config.UsernameClaim))
+	}
+
+	return &api.UserInfo{
+		Username: username,
+		Groups:   stringsClaim(claims[a.config.GroupsClaim]),
+	}, nil
+}
+
+func (a *JWTAuthenticator) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, err := a.lookupKey(kid)
+	if err != nil {
+		return nil, err
+	}
+
+	switch token.Method.(type) {
+	case *jwt.SigningMethodRSA:
+		if _, ok := key.(*rsa.PublicKey); !ok {
+			return nil, fmt.Errorf("key %s is not a RSA key", kid)
+		}
+	case *jwt.SigningMethodECDSA:
+		if _, ok := key.(*ecdsa.PublicKey); !ok {
+			return nil, fmt.Errorf("key %s is not an EC key", kid)
+		}
+	}
+
+	return key, nil
+}
+
+func (a *JWTAuthenticator) lookupKey(kid string) (crypto.PublicKey, error) {
+	a.lock.RLock()
+	key, ok := a.findKey(kid)
+	a.lock.RUnlock()
+
+	if ok {
+		return key, nil
+	}
+
+	// The key set may have been rotated, reload it but never more than once per interval. Callers
+	// waiting for an ongoing refresh look the key up again once it is done.
+	a.refreshLock.Lock()
+	defer a.refreshLock.Unlock()
+
+	a.lock.RLock()
+	key, ok = a.findKey(kid)
+	canRefresh := time.Since(a.lastRefresh) > jwksRefreshInterval
+	a.lock.RUnlock()
+
+	if ok {
+		return key, nil
+	}
+
+	if canRefresh {
+		if err := a.refreshKeys(); err != nil {
+			return nil, err
+		}
+
+		a.lock.RLock()
+		key, ok = a.findKey(kid)
+		a.lock.RUnlock()
+		if ok {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no signing key found for kid %q", kid)
+}
+
+// findKey must be called with the lock held
+func (a *JWTAuthenticator) findKey(kid string) (crypto.PublicKey, bool) {
+	if kid != "" {
+		key, ok := a.keys[kid]
+		return key, ok
+	}
+
+	// tokens without kid are only accepted when there is no ambiguity
+	if len(a.keys) == 1 {
+		for _, key := range a.keys {
+			return key, true
+		}
+	}
+
+	return nil, false
+}
+
+func (a *JWTAuthenticator) refreshKeys() error {
+	// failed attempts count as well, so that an unavailable identity provider isn't hammered
+	a.lock.Lock()
+	a.lastRefresh = time.Now()
+	a.lock.Unlock()
+
+	b, err := a.readJWKS()
+	if err != nil {
+		return fmt.Errorf("failed to read JWKS %s: %v", a.config.JWKS, err)
+	}
+
+	keys, err := parseJWKS(b)
+	if err != nil {
+		return fmt.Errorf("failed to parse JWKS %s: %v", a.config.JWKS, err)
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.keys = keys
+	return nil
+}
+
+func (a *JWTAuthenticator) readJWKS() ([]byte, error) {
+	if !strings.HasPrefix(a.config.JWKS, "https://") && !strings.HasPrefix(a.config.JWKS, "http://") {
+		return os.ReadFile(a.config.JWKS)
+	}
+
+	resp, err := a.httpClient.Get(a.config.JWKS)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) > maxJWKSSize {
+		return nil, fmt.Errorf("key set is larger than %d bytes", maxJWKSSize)
+	}
+
+	return b, nil
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func parseJWKS(b []byte) (map[string]crypto.PublicKey, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+
+	if err := json.Unmarshal(b, &set); err != nil {
+		return nil, err
+	}
+
+	keys := map[string]crypto.PublicKey{}
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %v", k.Kid, err)
+		}
+
+		keys[k.Kid] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no signing key found")
+	}
+
+	return keys, nil
+}
+
+func (k *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
+
+func bearerToken(c echo.Context) (string, bool) {
+	header := c.Request().Header.Get(echo.HeaderAuthorization)
+	if len(header) < 7 || !strings.EqualFold(header[:7], "bearer ") {
+		return "", false
+	}
+
+	return strings.TrimSpace(header[7:]), true
+}
+
+func stringsClaim(claim interface{}) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		values := []string{}
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
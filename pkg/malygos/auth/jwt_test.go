@@ -0,0 +1,161 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestJWTAuthenticator(t *testing.T) (*JWTAuthenticator, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "test",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+	require.NoError(t, err)
+
+	jwksPath := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwksPath, jwks, 0o600))
+
+	authenticator, err := NewJWTAuthenticator(JWTConfig{
+		Issuer:   "https://issuer.example.com",
+		Audience: "malygos",
+		JWKS:     jwksPath,
+	})
+	require.NoError(t, err)
+
+	return authenticator, key
+}
+
+func signedRequest(t *testing.T, key *rsa.PrivateKey, claims jwt.MapClaims) echo.Context {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "test"
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/clusters", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+signed)
+	return echo.New().NewContext(req, httptest.NewRecorder())
+}
+
+func Test_JWTAuthenticator(t *testing.T) {
+	authenticator, key := newTestJWTAuthenticator(t)
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":    "https://issuer.example.com",
+			"aud":    []string{"other", "malygos"},
+			"sub":    "alice",
+			"groups": []string{"ops", "dev"},
+			"exp":    time.Now().Add(time.Hour).Unix(),
+		}
+	}
+
+	user, err := authenticator.Authenticate(signedRequest(t, key, validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", user.Username)
+	assert.Equal(t, []string{"ops", "dev"}, user.Groups)
+
+	expired := validClaims()
+	expired["exp"] = time.Now().Add(-time.Hour).Unix()
+	_, err = authenticator.Authenticate(signedRequest(t, key, expired))
+	assert.True(t, errors.IsUnauthorized(err))
+
+	noExpiry := validClaims()
+	delete(noExpiry, "exp")
+	_, err = authenticator.Authenticate(signedRequest(t, key, noExpiry))
+	assert.True(t, errors.IsUnauthorized(err))
+
+	wrongIssuer := validClaims()
+	wrongIssuer["iss"] = "https://evil.example.com"
+	_, err = authenticator.Authenticate(signedRequest(t, key, wrongIssuer))
+	assert.True(t, errors.IsUnauthorized(err))
+
+	wrongAudience := validClaims()
+	wrongAudience["aud"] = "other"
+	_, err = authenticator.Authenticate(signedRequest(t, key, wrongAudience))
+	assert.True(t, errors.IsUnauthorized(err))
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, err = authenticator.Authenticate(signedRequest(t, otherKey, validClaims()))
+	assert.True(t, errors.IsUnauthorized(err))
+
+	// requests without bearer token are left to other authenticators
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/v1/clusters", nil), httptest.NewRecorder())
+	user, err = authenticator.Authenticate(c)
+	assert.NoError(t, err)
+	assert.Nil(t, user)
+}
+
+func Test_JWTAuthenticatorRefresh(t *testing.T) {
+	var fetches atomic.Int32
+	failing := atomic.Bool{}
+	jwks := `{"keys": [{"kty": "EC", "kid": "test", "crv": "P-256", "x": "AQ", "y": "AQ"}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(jwks))
+	}))
+	defer server.Close()
+
+	authenticator, err := NewJWTAuthenticator(JWTConfig{
+		Issuer:   "https://issuer.example.com",
+		Audience: "malygos",
+		JWKS:     server.URL,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), fetches.Load())
+
+	// unknown keys trigger a single refresh per interval, even when it fails
+	failing.Store(true)
+	authenticator.lastRefresh = time.Now().Add(-2 * jwksRefreshInterval)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := authenticator.lookupKey("unknown")
+			assert.Error(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), fetches.Load())
+
+	_, err = authenticator.lookupKey("unknown")
+	assert.Error(t, err)
+	assert.Equal(t, int32(2), fetches.Load())
+
+	// oversized key sets are rejected
+	failing.Store(false)
+	jwks = `{"keys": [], "padding": "` + strings.Repeat("a", maxJWKSSize) + `"}`
+	_, err = authenticator.readJWKS()
+	assert.ErrorContains(t, err, "larger than")
+}
@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/nrz-incubator/malygos/pkg/api"
+)
+
+// TokenAuthenticator authenticates the API tokens issued through /v1/tokens.
+// Only bearer tokens starting with prefix are handled, others are left to the next authenticator.
+type TokenAuthenticator struct {
+	prefix string
+	tokens api.TokenManager
+}
+
+func NewTokenAuthenticator(prefix string, tokens api.TokenManager) *TokenAuthenticator {
+	return &TokenAuthenticator{
+		prefix: prefix,
+		tokens: tokens,
+	}
+}
+
+func (a *TokenAuthenticator) Authenticate(c echo.Context) (*api.UserInfo, error) {
+	token, ok := bearerToken(c)
+	if !ok || !strings.HasPrefix(token, a.prefix) {
+		return nil, nil
+	}
+
+	return a.tokens.Authenticate(token)
+}
+
+// TokenOwnerAuthenticator wraps the authenticator of a method tokens can be issued from, so that the
+// tokens of a user follow the groups the user currently authenticates with.
+type TokenOwnerAuthenticator struct {
+	authenticator Authenticator
+	tokens        api.TokenManager
+}
+
+func NewTokenOwnerAuthenticator(authenticator Authenticator, tokens api.TokenManager) *TokenOwnerAuthenticator {
+	return &TokenOwnerAuthenticator{
+		authenticator: authenticator,
+		tokens:        tokens,
+	}
+}
+
+func (a *TokenOwnerAuthenticator) Authenticate(c echo.Context) (*api.UserInfo, error) {
+	user, err := a.authenticator.Authenticate(c)
+	if err == nil && user != nil {
+		a.tokens.RefreshGroups(user)
+	}
+
+	return user, err
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/nrz-incubator/malygos/pkg/api"
 	"github.com/nrz-incubator/malygos/pkg/errors"
 	"github.com/nrz-incubator/malygos/pkg/util"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/dynamic"
 )
@@ -24,24 +25,28 @@ func NewInKubeCatalogManager(client *dynamic.DynamicClient, namespace string) (*
 	}, nil
 }
 
-func (m *InKubeCatalogManager) ListComponents() ([]api.CatalogComponent, error) {
+func (m *InKubeCatalogManager) ListComponents(options api.PageOptions) ([]api.CatalogComponent, string, error) {
 	unstructuredList, err := m.client.Resource(malygosv1.GroupVersion.WithResource("components")).
-		Namespace(m.cfgNamespace).List(context.Background(), metav1.ListOptions{})
+		Namespace(m.cfgNamespace).
+		List(context.Background(), metav1.ListOptions{Limit: options.Limit, Continue: options.Continue})
 	if err != nil {
-		return nil, err
+		if options.Continue != "" && (k8serrors.IsResourceExpired(err) || k8serrors.IsBadRequest(err)) {
+			return nil, "", errors.NewInvalidArgumentError("continue token is expired or invalid, the list must be restarted")
+		}
+		return nil, "", err
 	}
 
-	var components []api.CatalogComponent
+	components := []api.CatalogComponent{}
 	for _, item := range unstructuredList.Items {
 		var component api.CatalogComponent
 		err := util.ConvertUnstructured(&item, &component)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 
 		versions, err := m.listComponentVersions(component.Name)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 
 		versionList := []string{}
@@ -53,7 +58,7 @@ func (m *InKubeCatalogManager) ListComponents() ([]api.CatalogComponent, error)
 		components = append(components, component)
 	}
 
-	return components, nil
+	return components, unstructuredList.GetContinue(), nil
 }
 
 func (m *InKubeCatalogManager) AddComponent(component *api.CatalogComponent) error {
@@ -259,3 +264,35 @@ func (m *InKubeCatalogManager) ListComponentVersionSubscriptions(componentName s
 func (m *InKubeCatalogManager) UnsubscribeComponentVersion(region string, clusterId string, componentName string, componentVersion string) error {
 	panic("implement me")
 }
+
+// DeleteClusterSubscriptions removes the cluster record the controller installs subscribed components from
+func (m *InKubeCatalogManager) DeleteClusterSubscriptions(region string, clusterId string) error {
+	resource := m.client.Resource(malygosv1.GroupVersion.WithResource("clusters")).Namespace(m.cfgNamespace)
+	unstructuredCluster, err := resource.Get(context.Background(), clusterId, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get cluster record: %v", err)
+	}
+
+	var cluster malygosv1.Cluster
+	if err := util.ConvertUnstructured(unstructuredCluster, &cluster); err != nil {
+		return fmt.Errorf("failed to convert cluster record: %v", err)
+	}
+
+	// cluster ids are only unique within a region
+	if cluster.Spec.Region != region {
+		return nil
+	}
+
+	err = resource.Delete(context.Background(), clusterId, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &cluster.UID},
+	})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete cluster record: %v", err)
+	}
+
+	return nil
+}
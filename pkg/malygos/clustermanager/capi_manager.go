@@ -0,0 +1,759 @@
+package clustermanager
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// CAPIProviderName is the name registrars declare the Cluster API provider with
+	CAPIProviderName = "capi"
+	// CAPITopologyAnnotation holds the JSON encoded CAPITopology of a Cluster API registrar.
+	CAPITopologyAnnotation = "malygos.local/capi-topology"
+	// capiClusterNameLabel is set by Cluster API on the resources of a cluster, like its kubeconfig secret
+	capiClusterNameLabel = "cluster.x-k8s.io/cluster-name"
+	// versionAnnotation keeps the version of clusters without class, whose control plane holds it
+	versionAnnotation = "malygos.local/version"
+	capiKubeconfigKey = "value"
+)
+
+// CAPIClusterResource is the resource Cluster API clusters are stored as on registrars.
+var CAPIClusterResource = schema.GroupVersionResource{Group: "cluster.x-k8s.io", Version: "v1beta1", Resource: "clusters"}
+
+// CAPITopology configures how the clusters of a Cluster API region are created. Clusters are either
+// created from a ClusterClass, which generates their control plane, or along with the control plane
+// and infrastructure objects templated here.
+type CAPITopology struct {
+	// Class is the ClusterClass clusters are created from
+	Class string `json:"class,omitempty"`
+	// Variables are passed to the class for every cluster
+	Variables []CAPIVariable `json:"variables,omitempty"`
+	// Workers are the machine deployments of the class created with every cluster
+	Workers []CAPIWorkers `json:"workers,omitempty"`
+	// ControlPlane is the control plane object of clusters without class, its spec.version and
+	// spec.replicas are set from the cluster
+	ControlPlane map[string]interface{} `json:"control_plane,omitempty"`
+	// Infrastructure is the infrastructure cluster object of clusters without class, it is optional
+	Infrastructure map[string]interface{} `json:"infrastructure,omitempty"`
+	// Resources maps the control plane and infrastructure kinds of the region to their resource, the
+	// kinds of the common Cluster API providers are already known
+	Resources map[string]string `json:"resources,omitempty"`
+}
+
+type CAPIVariable struct {
+	Name  string      `json:"name"`
+	Value interface{} `json:"value"`
+}
+
+type CAPIWorkers struct {
+	Class    string `json:"class"`
+	Name     string `json:"name"`
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// GetCAPITopology returns the topology of a Cluster API region, nil when it has none.
+func GetCAPITopology(registrar *api.ClusterRegistrar) (*CAPITopology, error) {
+	value, ok := registrar.Annotations[CAPITopologyAnnotation]
+	if !ok || value == "" {
+		return nil, nil
+	}
+
+	topology := &CAPITopology{}
+	if err := json.Unmarshal([]byte(value), topology); err != nil {
+		return nil, fmt.Errorf("invalid cluster API topology on registrar %s: %v", registrar.Name, err)
+	}
+
+	if (topology.Class == "") == (topology.ControlPlane == nil) {
+		return nil, fmt.Errorf("cluster API topology of registrar %s must set either class or control_plane", registrar.Name)
+	}
+
+	for field, template := range map[string]map[string]interface{}{"control_plane": topology.ControlPlane, "infrastructure": topology.Infrastructure} {
+		obj := &unstructured.Unstructured{Object: template}
+		if template == nil {
+			continue
+		}
+
+		if obj.GetAPIVersion() == "" || obj.GetKind() == "" {
+			return nil, fmt.Errorf("cluster API topology %s of registrar %s must set apiVersion and kind", field, registrar.Name)
+		}
+
+		if _, err := capiResource(topology, obj.GetAPIVersion(), obj.GetKind()); err != nil {
+			return nil, fmt.Errorf("invalid cluster API topology %s of registrar %s: %v", field, registrar.Name, err)
+		}
+	}
+
+	return topology, nil
+}
+
+type CAPIClusterManager struct {
+	client    dynamic.Interface
+	logger    logr.Logger
+	namespace string
+	topology  *CAPITopology
+}
+
+func NewCAPIClusterManager(logger logr.Logger, client dynamic.Interface, namespace string, topology *CAPITopology) *CAPIClusterManager {
+	return &CAPIClusterManager{
+		client:    client,
+		logger:    logger,
+		namespace: namespace,
+		topology:  topology,
+	}
+}
+
+func (m *CAPIClusterManager) Create(cluster *api.Cluster) (*api.Cluster, error) {
+	if m.topology == nil {
+		return nil, fmt.Errorf("region %s has no cluster API topology", cluster.Region)
+	}
+
+	owner, err := m.nameOwner(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if owner != "" {
+		return nil, errors.NewConflictError("cluster", cluster.Name)
+	}
+
+	clusterID := generateClusterID()
+	created, err := m.client.Resource(CAPIClusterResource).
+		Namespace(m.namespace).
+		Create(context.TODO(), buildCAPICluster(clusterID, cluster, m.topology), metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cluster API cluster: %v", err)
+	}
+
+	// concurrent creations with the same name all keep the oldest cluster
+	owner, err = m.nameOwner(cluster)
+	if err != nil || owner != clusterID {
+		m.deleteIncomplete(clusterID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.NewConflictError("cluster", cluster.Name)
+	}
+
+	// the objects are owned by the cluster so that they are garbage collected with it
+	for _, obj := range buildCAPIReferences(created, cluster, m.topology) {
+		resource, err := capiResource(m.topology, obj.GetAPIVersion(), obj.GetKind())
+		if err == nil {
+			_, err = m.client.Resource(resource).
+				Namespace(m.namespace).
+				Create(context.TODO(), obj, metav1.CreateOptions{})
+		}
+		if err != nil {
+			m.deleteIncomplete(clusterID)
+			return nil, fmt.Errorf("failed to create cluster API %s: %v", obj.GetKind(), err)
+		}
+	}
+
+	cluster.Id = &clusterID
+	cluster.Status = &api.ClusterStatus{
+		Phase:  "Pending",
+		Online: false,
+	}
+
+	return cluster, nil
+}
+
+// nameOwner returns the id of the oldest cluster of the region named like cluster, empty when there is none
+func (m *CAPIClusterManager) nameOwner(cluster *api.Cluster) (string, error) {
+	clusters, err := m.list(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", regionalClusterLabel, cluster.Region),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	objects := make([]metav1.Object, 0, len(clusters.Items))
+	for i := range clusters.Items {
+		objects = append(objects, &clusters.Items[i])
+	}
+
+	return oldestNamed(objects, cluster.Name), nil
+}
+
+func (m *CAPIClusterManager) deleteIncomplete(clusterID string) {
+	if err := m.Delete(clusterID); err != nil {
+		m.logger.Error(err, "failed to delete incomplete cluster API cluster", "id", clusterID)
+	}
+}
+
+// buildCAPICluster returns the Cluster API cluster of a cluster, referencing the objects of
+// buildCAPIReferences when the topology has no class
+func buildCAPICluster(clusterID string, cluster *api.Cluster, topology *CAPITopology) *unstructured.Unstructured {
+	capiCluster := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{},
+	}}
+	capiCluster.SetAPIVersion(CAPIClusterResource.GroupVersion().String())
+	capiCluster.SetKind("Cluster")
+	capiCluster.SetName(clusterID)
+
+	clusterLabels, annotations := clusterMetadata(cluster)
+	capiCluster.SetLabels(clusterLabels)
+	capiCluster.SetAnnotations(annotations)
+
+	if topology.Class != "" {
+		variables := []interface{}{}
+		for _, variable := range topology.Variables {
+			variables = append(variables, map[string]interface{}{"name": variable.Name, "value": variable.Value})
+		}
+
+		workers := []interface{}{}
+		for _, worker := range topology.Workers {
+			deployment := map[string]interface{}{"class": worker.Class, "name": worker.Name}
+			if worker.Replicas != nil {
+				deployment["replicas"] = int64(*worker.Replicas)
+			}
+			workers = append(workers, deployment)
+		}
+
+		capiCluster.Object["spec"] = map[string]interface{}{
+			"topology": map[string]interface{}{
+				"class":   topology.Class,
+				"version": cluster.Version,
+				"controlPlane": map[string]interface{}{
+					"replicas": int64(controlPlaneReplicas(cluster)),
+				},
+				"variables": variables,
+				"workers": map[string]interface{}{
+					"machineDeployments": workers,
+				},
+			},
+		}
+		return capiCluster
+	}
+
+	// without class the version is only held by the control plane object
+	annotations[versionAnnotation] = cluster.Version
+	capiCluster.SetAnnotations(annotations)
+
+	spec := capiCluster.Object["spec"].(map[string]interface{})
+	spec["controlPlaneRef"] = objectReference(topology.ControlPlane, controlPlaneName(clusterID))
+	if topology.Infrastructure != nil {
+		spec["infrastructureRef"] = objectReference(topology.Infrastructure, clusterID)
+	}
+
+	return capiCluster
+}
+
+// buildCAPIReferences returns the control plane and infrastructure objects of a cluster without class
+func buildCAPIReferences(capiCluster *unstructured.Unstructured, cluster *api.Cluster, topology *CAPITopology) []*unstructured.Unstructured {
+	if topology.Class != "" {
+		return nil
+	}
+
+	controlPlane := buildCAPIObject(capiCluster, topology.ControlPlane, controlPlaneName(capiCluster.GetName()))
+	_ = unstructured.SetNestedField(controlPlane.Object, cluster.Version, "spec", "version")
+	_ = unstructured.SetNestedField(controlPlane.Object, int64(controlPlaneReplicas(cluster)), "spec", "replicas")
+	objects := []*unstructured.Unstructured{controlPlane}
+
+	if topology.Infrastructure != nil {
+		objects = append(objects, buildCAPIObject(capiCluster, topology.Infrastructure, capiCluster.GetName()))
+	}
+
+	return objects
+}
+
+func buildCAPIObject(capiCluster *unstructured.Unstructured, template map[string]interface{}, name string) *unstructured.Unstructured {
+	obj := (&unstructured.Unstructured{Object: template}).DeepCopy()
+	obj.SetName(name)
+	obj.SetLabels(map[string]string{capiClusterNameLabel: capiCluster.GetName()})
+	obj.SetOwnerReferences([]metav1.OwnerReference{{
+		APIVersion: capiCluster.GetAPIVersion(),
+		Kind:       capiCluster.GetKind(),
+		Name:       capiCluster.GetName(),
+		UID:        capiCluster.GetUID(),
+	}})
+
+	return obj
+}
+
+func objectReference(template map[string]interface{}, name string) map[string]interface{} {
+	obj := &unstructured.Unstructured{Object: template}
+	return map[string]interface{}{
+		"apiVersion": obj.GetAPIVersion(),
+		"kind":       obj.GetKind(),
+		"name":       name,
+	}
+}
+
+func controlPlaneName(clusterID string) string {
+	return clusterID + "-control-plane"
+}
+
+// controlPlaneReplicas returns the replicas the API resolved, or the built-in default
+func controlPlaneReplicas(cluster *api.Cluster) int32 {
+	if cluster.ControlPlane != nil && cluster.ControlPlane.Replicas != nil {
+		return *cluster.ControlPlane.Replicas
+	}
+
+	return *api.DefaultControlPlane().Replicas
+}
+
+func (m *CAPIClusterManager) Delete(id string) error {
+	err := m.client.Resource(CAPIClusterResource).
+		Namespace(m.namespace).
+		Delete(context.TODO(), id, metav1.DeleteOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return errors.NewNotFoundError("cluster API cluster", id)
+		}
+		return fmt.Errorf("failed to delete cluster API cluster: %v", err)
+	}
+	return nil
+}
+
+// WaitForDeletion blocks until the cluster and the secrets Cluster API generated for it are removed
+func (m *CAPIClusterManager) WaitForDeletion(ctx context.Context, id string) error {
+	return wait.PollUntilContextCancel(ctx, deletionPollInterval, true, func(ctx context.Context) (bool, error) {
+		_, err := m.client.Resource(CAPIClusterResource).
+			Namespace(m.namespace).
+			Get(ctx, id, metav1.GetOptions{})
+		if err == nil {
+			return false, nil
+		}
+
+		// transient errors are retried until the deadline
+		if !k8serrors.IsNotFound(err) {
+			m.logger.Error(err, "failed to get cluster API cluster", "id", id)
+			return false, nil
+		}
+
+		secrets, err := m.client.Resource(secretsResource).
+			Namespace(m.namespace).
+			List(ctx, metav1.ListOptions{LabelSelector: capiClusterNameLabel + "=" + id})
+		if err != nil {
+			m.logger.Error(err, "failed to list cluster API cluster secrets", "id", id)
+			return false, nil
+		}
+
+		return len(secrets.Items) == 0, nil
+	})
+}
+
+// List returns the clusters along with their control plane, each control plane kind is listed once
+func (m *CAPIClusterManager) List(options api.ClusterListOptions) ([]*api.Cluster, string, error) {
+	selector := labels.NewSelector()
+	if options.LabelSelector != "" {
+		userSelector, err := api.UserLabelSelector(options.LabelSelector)
+		if err != nil {
+			return nil, "", err
+		}
+		requirements, _ := userSelector.Requirements()
+		selector = selector.Add(requirements...)
+	}
+
+	// Cluster API clusters not created by Malygos may share the namespace
+	managed, err := labels.NewRequirement(regionalClusterLabel, selection.Exists, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to build cluster selector: %v", err)
+	}
+
+	capiClusters, err := m.list(metav1.ListOptions{
+		LabelSelector: selector.Add(*managed).String(),
+		Limit:         options.Limit,
+		Continue:      options.Continue,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	slices.SortFunc(capiClusters.Items, func(a, b unstructured.Unstructured) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	controlPlanes, err := m.controlPlanes(capiClusters.Items)
+	if err != nil {
+		return nil, "", err
+	}
+
+	clusters := make([]*api.Cluster, 0)
+	for _, capiCluster := range capiClusters.Items {
+		clusters = append(clusters, capiToAPICluster(&capiCluster, controlPlanes[capiCluster.GetName()]))
+	}
+
+	return clusters, capiClusters.GetContinue(), nil
+}
+
+func (m *CAPIClusterManager) list(options metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	capiClusters, err := m.client.Resource(CAPIClusterResource).
+		Namespace(m.namespace).
+		List(context.TODO(), options)
+	if err != nil {
+		if options.Continue != "" && (k8serrors.IsResourceExpired(err) || k8serrors.IsBadRequest(err)) {
+			return nil, errors.NewInvalidArgumentError("continue token is expired or invalid, the list must be restarted")
+		}
+		return nil, fmt.Errorf("failed to list cluster API clusters: %v", err)
+	}
+
+	return capiClusters, nil
+}
+
+func (m *CAPIClusterManager) Get(id string) (*api.Cluster, error) {
+	capiCluster, err := m.get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	controlPlane, err := m.getControlPlane(capiCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return capiToAPICluster(capiCluster, controlPlane), nil
+}
+
+func (m *CAPIClusterManager) get(id string) (*unstructured.Unstructured, error) {
+	capiCluster, err := m.client.Resource(CAPIClusterResource).
+		Namespace(m.namespace).
+		Get(context.TODO(), id, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, errors.NewNotFoundError("cluster API cluster", id)
+		}
+		return nil, fmt.Errorf("failed to get cluster API cluster: %v", err)
+	}
+
+	return capiCluster, nil
+}
+
+// getControlPlane returns the control plane object of a cluster, nil until it is created
+func (m *CAPIClusterManager) getControlPlane(capiCluster *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	return getCAPIControlPlane(m.client, m.topology, capiCluster, m.namespace)
+}
+
+// controlPlanes returns the control plane objects of clusters by cluster id, the clusters whose
+// control plane isn't created yet are left out
+func (m *CAPIClusterManager) controlPlanes(capiClusters []unstructured.Unstructured) (map[string]*unstructured.Unstructured, error) {
+	type source struct {
+		resource  schema.GroupVersionResource
+		namespace string
+	}
+
+	listed := map[source]map[string]*unstructured.Unstructured{}
+	controlPlanes := map[string]*unstructured.Unstructured{}
+	for i := range capiClusters {
+		capiCluster := &capiClusters[i]
+		ref, found, _ := unstructured.NestedStringMap(capiCluster.Object, "spec", "controlPlaneRef")
+		if !found || ref["name"] == "" {
+			continue
+		}
+
+		resource, err := capiResource(m.topology, ref["apiVersion"], ref["kind"])
+		if err != nil {
+			return nil, err
+		}
+
+		key := source{resource: resource, namespace: referenceNamespace(ref, capiCluster, m.namespace)}
+		objects, ok := listed[key]
+		if !ok {
+			list, err := m.client.Resource(resource).Namespace(key.namespace).List(context.TODO(), metav1.ListOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list cluster API control planes: %v", err)
+			}
+
+			objects = map[string]*unstructured.Unstructured{}
+			for j := range list.Items {
+				objects[list.Items[j].GetName()] = &list.Items[j]
+			}
+			listed[key] = objects
+		}
+
+		if controlPlane, ok := objects[ref["name"]]; ok {
+			controlPlanes[capiCluster.GetName()] = controlPlane
+		}
+	}
+
+	return controlPlanes, nil
+}
+
+// getCAPIControlPlane returns the control plane object of a cluster, nil until it is created
+func getCAPIControlPlane(client dynamic.Interface, topology *CAPITopology, capiCluster *unstructured.Unstructured,
+	namespace string) (*unstructured.Unstructured, error) {
+	ref, found, _ := unstructured.NestedStringMap(capiCluster.Object, "spec", "controlPlaneRef")
+	if !found || ref["name"] == "" {
+		return nil, nil
+	}
+
+	resource, err := capiResource(topology, ref["apiVersion"], ref["kind"])
+	if err != nil {
+		return nil, err
+	}
+
+	controlPlane, err := client.Resource(resource).
+		Namespace(referenceNamespace(ref, capiCluster, namespace)).
+		Get(context.TODO(), ref["name"], metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get cluster API control plane: %v", err)
+	}
+
+	return controlPlane, nil
+}
+
+// referenceNamespace returns the namespace of an object referenced by a cluster, references default
+// to the namespace of the cluster
+func referenceNamespace(ref map[string]string, capiCluster *unstructured.Unstructured, namespace string) string {
+	if ref["namespace"] != "" {
+		return ref["namespace"]
+	}
+
+	if capiCluster.GetNamespace() != "" {
+		return capiCluster.GetNamespace()
+	}
+
+	return namespace
+}
+
+// GetKubeconfig returns the admin kubeconfig Cluster API generates, it already targets the
+// control plane endpoint
+func (m *CAPIClusterManager) GetKubeconfig(id string) (string, error) {
+	capiCluster, err := m.get(id)
+	if err != nil {
+		return "", err
+	}
+
+	ready, _, _ := unstructured.NestedBool(capiCluster.Object, "status", "controlPlaneReady")
+	if !ready {
+		return "", errors.NewNotReadyError("cluster", id)
+	}
+
+	secret, err := m.client.Resource(secretsResource).
+		Namespace(m.namespace).
+		Get(context.TODO(), id+"-kubeconfig", metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return "", errors.NewNotReadyError("cluster", id)
+		}
+		return "", fmt.Errorf("failed to get kubeconfig secret: %v", err)
+	}
+
+	encoded, found, err := unstructured.NestedString(secret.Object, "data", capiKubeconfigKey)
+	if err != nil || !found {
+		return "", errors.NewNotReadyError("cluster", id)
+	}
+
+	b, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode kubeconfig: %v", err)
+	}
+
+	return string(b), nil
+}
+
+func (m *CAPIClusterManager) SetOwnership(id string, owner string, team string) error {
+	patch, err := ownershipPatch(owner, team)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.client.Resource(CAPIClusterResource).
+		Namespace(m.namespace).
+		Patch(context.TODO(), id, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return errors.NewNotFoundError("cluster API cluster", id)
+		}
+		return fmt.Errorf("failed to update cluster API cluster ownership: %v", err)
+	}
+
+	return nil
+}
+
+func (m *CAPIClusterManager) Update(id string, update *api.ClusterUpdate) (*api.Cluster, error) {
+	if update.Addons != nil {
+		return nil, errors.NewInvalidArgumentError("addons are not managed by the cluster API provider")
+	}
+
+	capiCluster, err := m.get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	controlPlane, err := m.getControlPlane(capiCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := capiToAPICluster(capiCluster, controlPlane)
+	if update.Version == nil || *update.Version == cluster.Version {
+		return cluster, nil
+	}
+
+	// the control plane is upgraded in place, stacking upgrades would skip minor versions
+	if cluster.Status.Version == nil || *cluster.Status.Upgrading {
+		return nil, errors.NewNotReadyError("cluster", id)
+	}
+
+	// the resource versions make the patches fail if the cluster changed since it was validated
+	if _, found, _ := unstructured.NestedMap(capiCluster.Object, "spec", "topology"); found {
+		err := m.patch(CAPIClusterResource, id, map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"resourceVersion": capiCluster.GetResourceVersion(),
+			},
+			"spec": map[string]interface{}{
+				"topology": map[string]interface{}{"version": *update.Version},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return m.Get(id)
+	}
+
+	// without class the control plane holds the version so it is patched first, the annotation
+	// only reports the version until the control plane is created
+	ref, _, _ := unstructured.NestedStringMap(capiCluster.Object, "spec", "controlPlaneRef")
+	resource, err := capiResource(m.topology, ref["apiVersion"], ref["kind"])
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.patch(resource, ref["name"], map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": controlPlane.GetResourceVersion(),
+		},
+		"spec": map[string]interface{}{"version": *update.Version},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.patch(CAPIClusterResource, id, map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{versionAnnotation: *update.Version},
+		},
+	})
+	if err != nil {
+		// the upgrade is already applied to the control plane, which the version is read from
+		m.logger.Error(err, "failed to record cluster API cluster version", "id", id)
+	}
+
+	return m.Get(id)
+}
+
+func (m *CAPIClusterManager) patch(resource schema.GroupVersionResource, name string, patch map[string]interface{}) error {
+	b, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("failed to marshal update patch: %v", err)
+	}
+
+	_, err = m.client.Resource(resource).
+		Namespace(m.namespace).
+		Patch(context.TODO(), name, types.MergePatchType, b, metav1.PatchOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return errors.NewNotFoundError(resource.Resource, name)
+		}
+		if k8serrors.IsConflict(err) {
+			return errors.NewModifiedError("cluster", name)
+		}
+		return fmt.Errorf("failed to update %s: %v", resource.Resource, err)
+	}
+
+	return nil
+}
+
+// Usage counts the clusters and control plane replicas of team, the control planes run on
+// machines of the infrastructure provider so they don't use management cluster capacity
+func (m *CAPIClusterManager) Usage(team string) (*api.ResourceUsage, error) {
+	// team-less clusters count against their owner, which can't be selected by label
+	capiClusters, err := m.list(metav1.ListOptions{
+		LabelSelector: regionalClusterLabel,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counted := []unstructured.Unstructured{}
+	for _, capiCluster := range capiClusters.Items {
+		if team == "" || quotaTeam(capiCluster.GetLabels(), capiCluster.GetAnnotations()) == team {
+			counted = append(counted, capiCluster)
+		}
+	}
+
+	controlPlanes, err := m.controlPlanes(counted)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &api.ResourceUsage{}
+	for _, capiCluster := range counted {
+		replicas, found, _ := unstructured.NestedInt64(capiCluster.Object, "spec", "topology", "controlPlane", "replicas")
+		if controlPlane := controlPlanes[capiCluster.GetName()]; !found && controlPlane != nil {
+			replicas, _, _ = unstructured.NestedInt64(controlPlane.Object, "spec", "replicas")
+		}
+
+		usage.Add(&api.ResourceUsage{Clusters: 1, Replicas: replicas})
+	}
+
+	return usage, nil
+}
+
+func (m *CAPIClusterManager) Footprint(cluster *api.Cluster) (*api.ResourceUsage, error) {
+	return &api.ResourceUsage{
+		Clusters: 1,
+		Replicas: int64(controlPlaneReplicas(cluster)),
+	}, nil
+}
+
+// UnstructuredCAPIToAPICluster converts a Cluster API cluster read through a dynamic client without
+// its control plane, the running version, upgrade and replicas of the status are left unset.
+func UnstructuredCAPIToAPICluster(obj *unstructured.Unstructured) (*api.Cluster, error) {
+	return capiToAPICluster(obj, nil), nil
+}
+
+func capiToAPICluster(capiCluster *unstructured.Unstructured, controlPlane *unstructured.Unstructured) *api.Cluster {
+	// without class the control plane holds the version, the annotation covers the time until it is created
+	version, found, _ := unstructured.NestedString(capiCluster.Object, "spec", "topology", "version")
+	if !found && controlPlane != nil {
+		version, found, _ = unstructured.NestedString(controlPlane.Object, "spec", "version")
+	}
+	if !found {
+		version = capiCluster.GetAnnotations()[versionAnnotation]
+	}
+
+	cluster := &api.Cluster{
+		Id:      ptr.To(capiCluster.GetName()),
+		Version: version,
+		Status:  capiToAPIClusterStatus(capiCluster, controlPlane, version),
+	}
+	setClusterMetadata(cluster, capiCluster.GetLabels(), capiCluster.GetAnnotations())
+
+	if replicas, found, _ := unstructured.NestedInt64(capiCluster.Object, "spec", "topology", "controlPlane", "replicas"); found {
+		cluster.ControlPlane = &api.ControlPlane{Replicas: ptr.To(int32(replicas))}
+	} else if controlPlane != nil {
+		if replicas, found, _ := unstructured.NestedInt64(controlPlane.Object, "spec", "replicas"); found {
+			cluster.ControlPlane = &api.ControlPlane{Replicas: ptr.To(int32(replicas))}
+		}
+	}
+
+	return cluster
+}
+
+// ListSubscriptions isn't implemented yet by the Cluster API provider
+func (m *CAPIClusterManager) ListSubscriptions(id string) ([]*api.CatalogComponent, error) {
+	return nil, errors.NewNotImplementedError("cluster subscriptions of the cluster API provider")
+}
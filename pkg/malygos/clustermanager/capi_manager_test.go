@@ -0,0 +1,410 @@
+package clustermanager
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+)
+
+var kubeadmControlPlaneResource = schema.GroupVersionResource{Group: "controlplane.cluster.x-k8s.io", Version: "v1beta1",
+	Resource: "kubeadmcontrolplanes"}
+
+func newCAPIClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		CAPIClusterResource:         "ClusterList",
+		secretsResource:             "SecretList",
+		kubeadmControlPlaneResource: "KubeadmControlPlaneList",
+	}, objects...)
+}
+
+// reconcile emulates Cluster API provisioning a cluster whose control plane runs version
+func reconcile(t *testing.T, client *dynamicfake.FakeDynamicClient, id string, version string) {
+	ctx := context.Background()
+	capiCluster, err := client.Resource(CAPIClusterResource).Namespace("malygos").Get(ctx, id, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, unstructured.SetNestedField(capiCluster.Object, "10.0.0.1", "spec", "controlPlaneEndpoint", "host"))
+	require.NoError(t, unstructured.SetNestedField(capiCluster.Object, int64(6443), "spec", "controlPlaneEndpoint", "port"))
+	require.NoError(t, unstructured.SetNestedStringMap(capiCluster.Object, map[string]string{
+		"apiVersion": kubeadmControlPlaneResource.GroupVersion().String(),
+		"kind":       "KubeadmControlPlane",
+		"name":       controlPlaneName(id),
+	}, "spec", "controlPlaneRef"))
+	require.NoError(t, unstructured.SetNestedField(capiCluster.Object, map[string]interface{}{
+		"phase":             "Provisioned",
+		"controlPlaneReady": true,
+		"conditions": []interface{}{
+			map[string]interface{}{"type": "Ready", "status": "True", "lastTransitionTime": "2024-01-01T00:00:00Z"},
+			map[string]interface{}{"type": "ControlPlaneInitialized", "status": "True"},
+		},
+	}, "status"))
+	_, err = client.Resource(CAPIClusterResource).Namespace("malygos").Update(ctx, capiCluster, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	controlPlane, err := client.Resource(kubeadmControlPlaneResource).Namespace("malygos").Get(ctx, controlPlaneName(id), metav1.GetOptions{})
+	if err != nil {
+		// with a class, Cluster API generates the control plane
+		controlPlane = &unstructured.Unstructured{Object: map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(3)}}}
+		controlPlane.SetAPIVersion(kubeadmControlPlaneResource.GroupVersion().String())
+		controlPlane.SetKind("KubeadmControlPlane")
+		controlPlane.SetName(controlPlaneName(id))
+		controlPlane, err = client.Resource(kubeadmControlPlaneResource).Namespace("malygos").Create(ctx, controlPlane, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, unstructured.SetNestedField(controlPlane.Object, map[string]interface{}{
+		"version":         version,
+		"replicas":        int64(3),
+		"readyReplicas":   int64(3),
+		"updatedReplicas": int64(3),
+	}, "status"))
+	_, err = client.Resource(kubeadmControlPlaneResource).Namespace("malygos").Update(ctx, controlPlane, metav1.UpdateOptions{})
+	require.NoError(t, err)
+}
+
+func Test_GetCAPITopology(t *testing.T) {
+	topology, err := GetCAPITopology(&api.ClusterRegistrar{})
+	require.NoError(t, err)
+	assert.Nil(t, topology)
+
+	topology, err = GetCAPITopology(&api.ClusterRegistrar{Annotations: map[string]string{CAPITopologyAnnotation: `{
+		"class": "quick-start",
+		"variables": [{"name": "imageRepository", "value": "registry.k8s.io"}],
+		"workers": [{"class": "default-worker", "name": "md-0", "replicas": 2}]
+	}`}})
+	require.NoError(t, err)
+	assert.Equal(t, "quick-start", topology.Class)
+	assert.Equal(t, "md-0", topology.Workers[0].Name)
+
+	for _, value := range []string{
+		`{`,
+		`{}`,
+		`{"class": "quick-start", "control_plane": {"apiVersion": "controlplane.cluster.x-k8s.io/v1beta1", "kind": "KubeadmControlPlane"}}`,
+		`{"control_plane": {"kind": "KubeadmControlPlane"}}`,
+		`{"control_plane": {"apiVersion": "controlplane.cluster.x-k8s.io/v1beta1", "kind": "KubeadmControlPlane"}, "infrastructure": {}}`,
+		`{"control_plane": {"apiVersion": "controlplane.example.com/v1", "kind": "CustomControlPlane"}}`,
+	} {
+		_, err := GetCAPITopology(&api.ClusterRegistrar{Annotations: map[string]string{CAPITopologyAnnotation: value}})
+		assert.Error(t, err, value)
+	}
+
+	// kinds unknown to Malygos are declared along with their resource
+	topology, err = GetCAPITopology(&api.ClusterRegistrar{Annotations: map[string]string{CAPITopologyAnnotation: `{
+		"control_plane": {"apiVersion": "controlplane.example.com/v1", "kind": "CustomControlPlane"},
+		"resources": {"CustomControlPlane": "customcontrolplanes"}
+	}`}})
+	require.NoError(t, err)
+	resource, err := capiResource(topology, "controlplane.example.com/v1", "CustomControlPlane")
+	require.NoError(t, err)
+	assert.Equal(t, schema.GroupVersionResource{Group: "controlplane.example.com", Version: "v1", Resource: "customcontrolplanes"}, resource)
+}
+
+func Test_CAPIClusterManager_Class(t *testing.T) {
+	client := newCAPIClient()
+	m := NewCAPIClusterManager(logr.Discard(), client, "malygos", &CAPITopology{
+		Class:     "quick-start",
+		Variables: []CAPIVariable{{Name: "imageRepository", Value: "registry.k8s.io"}},
+		Workers:   []CAPIWorkers{{Class: "default-worker", Name: "md-0", Replicas: ptr.To(int32(2))}},
+	})
+
+	cluster, err := m.Create(&api.Cluster{Name: "a", Region: "eu", Version: "v1.29.0", Owner: ptr.To("alice"),
+		ControlPlane: &api.ControlPlane{Replicas: ptr.To(int32(3))}, Labels: &map[string]string{"env": "dev"}})
+	require.NoError(t, err)
+	id := *cluster.Id
+
+	_, err = m.Create(&api.Cluster{Name: "a", Region: "eu", Version: "v1.29.0"})
+	assert.True(t, errors.IsConflict(err))
+
+	capiCluster, err := client.Resource(CAPIClusterResource).Namespace("malygos").Get(context.Background(), id, metav1.GetOptions{})
+	require.NoError(t, err)
+	class, _, _ := unstructured.NestedString(capiCluster.Object, "spec", "topology", "class")
+	assert.Equal(t, "quick-start", class)
+	replicas, _, _ := unstructured.NestedInt64(capiCluster.Object, "spec", "topology", "controlPlane", "replicas")
+	assert.Equal(t, int64(3), replicas)
+	workers, _, _ := unstructured.NestedSlice(capiCluster.Object, "spec", "topology", "workers", "machineDeployments")
+	assert.Len(t, workers, 1)
+	assert.Equal(t, "eu", capiCluster.GetLabels()[regionalClusterLabel])
+	assert.Equal(t, "dev", capiCluster.GetLabels()[api.UserLabelPrefix+"env"])
+
+	cluster, err = m.Get(id)
+	require.NoError(t, err)
+	assert.Equal(t, "a", cluster.Name)
+	assert.Equal(t, "v1.29.0", cluster.Version)
+	assert.Equal(t, "alice", *cluster.Owner)
+	assert.Equal(t, "Pending", cluster.Status.Phase)
+	assert.False(t, cluster.Status.Online)
+
+	_, err = m.GetKubeconfig(id)
+	assert.True(t, errors.IsNotReady(err))
+
+	reconcile(t, client, id, "v1.29.0")
+	cluster, err = m.Get(id)
+	require.NoError(t, err)
+	assert.True(t, cluster.Status.Online)
+	assert.Equal(t, "Ready", cluster.Status.Phase)
+	assert.Equal(t, "10.0.0.1:6443", *cluster.Status.Endpoint)
+	assert.Equal(t, "v1.29.0", *cluster.Status.Version)
+	assert.False(t, *cluster.Status.Upgrading)
+	assert.Equal(t, int32(3), cluster.Status.Replicas.Available)
+	assert.Equal(t, "ClusterReady", (*cluster.Status.Conditions)[4].Type)
+
+	// listed clusters report their control plane like Get, watched ones are converted without it
+	clusters, _, err := m.List(api.ClusterListOptions{})
+	require.NoError(t, err)
+	require.Len(t, clusters, 1)
+	assert.Equal(t, cluster, clusters[0])
+
+	capiCluster, err = client.Resource(CAPIClusterResource).Namespace("malygos").Get(context.Background(), id, metav1.GetOptions{})
+	require.NoError(t, err)
+	converted, err := UnstructuredCAPIToAPICluster(capiCluster)
+	require.NoError(t, err)
+	assert.Equal(t, cluster.Id, converted.Id)
+	assert.Equal(t, cluster.Version, converted.Version)
+	assert.Equal(t, cluster.Status.Phase, converted.Status.Phase)
+	assert.Equal(t, cluster.Status.Endpoint, converted.Status.Endpoint)
+	assert.Nil(t, converted.Status.Version)
+	assert.Nil(t, converted.Status.Replicas)
+
+	_, err = m.ListSubscriptions(id)
+	assert.True(t, errors.IsNotImplemented(err))
+
+	// the kubeconfig is written once the control plane is ready
+	_, err = m.GetKubeconfig(id)
+	assert.True(t, errors.IsNotReady(err))
+
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"data": map[string]interface{}{capiKubeconfigKey: base64.StdEncoding.EncodeToString([]byte("kubeconfig"))},
+	}}
+	secret.SetAPIVersion("v1")
+	secret.SetKind("Secret")
+	secret.SetName(id + "-kubeconfig")
+	secret.SetLabels(map[string]string{capiClusterNameLabel: id})
+	_, err = client.Resource(secretsResource).Namespace("malygos").Create(context.Background(), secret, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	kubeconfig, err := m.GetKubeconfig(id)
+	require.NoError(t, err)
+	assert.Equal(t, "kubeconfig", kubeconfig)
+
+	_, err = m.Update(id, &api.ClusterUpdate{Addons: api.DefaultAddons()})
+	assert.True(t, errors.IsInvalidArgument(err))
+
+	cluster, err = m.Update(id, &api.ClusterUpdate{Version: ptr.To("v1.30.0")})
+	require.NoError(t, err)
+	assert.Equal(t, "v1.30.0", cluster.Version)
+	assert.True(t, *cluster.Status.Upgrading)
+	assert.Equal(t, "Upgrading", cluster.Status.Phase)
+
+	_, err = m.Update(id, &api.ClusterUpdate{Version: ptr.To("v1.31.0")})
+	assert.True(t, errors.IsNotReady(err))
+
+	// team-less clusters count against their owner
+	require.NoError(t, m.SetOwnership(id, "bob", ""))
+	usage, err := m.Usage("bob")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), usage.Clusters)
+
+	require.NoError(t, m.SetOwnership(id, "bob", "team-a"))
+	usage, err = m.Usage("bob")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), usage.Clusters)
+
+	usage, err = m.Usage("team-a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), usage.Clusters)
+	assert.Equal(t, int64(3), usage.Replicas)
+
+	// clusters not created by Malygos are left out
+	foreign := &unstructured.Unstructured{}
+	foreign.SetAPIVersion(CAPIClusterResource.GroupVersion().String())
+	foreign.SetKind("Cluster")
+	foreign.SetName("foreign")
+	_, err = client.Resource(CAPIClusterResource).Namespace("malygos").Create(context.Background(), foreign, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	clusters, _, err = m.List(api.ClusterListOptions{LabelSelector: "env=dev"})
+	require.NoError(t, err)
+	require.Len(t, clusters, 1)
+	assert.Equal(t, id, *clusters[0].Id)
+	assert.Equal(t, "team-a", *clusters[0].Team)
+
+	clusters, _, err = m.List(api.ClusterListOptions{LabelSelector: "env=prod"})
+	require.NoError(t, err)
+	assert.Empty(t, clusters)
+
+	assert.True(t, errors.IsNotFound(m.Delete("missing")))
+	require.NoError(t, m.Delete(id))
+
+	// the secrets generated by Cluster API are still there
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	assert.Error(t, m.WaitForDeletion(ctx, id))
+
+	require.NoError(t, client.Resource(secretsResource).Namespace("malygos").Delete(context.Background(), id+"-kubeconfig", metav1.DeleteOptions{}))
+	assert.NoError(t, m.WaitForDeletion(context.Background(), id))
+}
+
+func Test_CAPIClusterManager_ControlPlane(t *testing.T) {
+	client := newCAPIClient()
+	m := NewCAPIClusterManager(logr.Discard(), client, "malygos", &CAPITopology{
+		ControlPlane: map[string]interface{}{
+			"apiVersion": kubeadmControlPlaneResource.GroupVersion().String(),
+			"kind":       "KubeadmControlPlane",
+			"spec":       map[string]interface{}{"machineTemplate": map[string]interface{}{}},
+		},
+		Infrastructure: map[string]interface{}{
+			"apiVersion": "infrastructure.cluster.x-k8s.io/v1beta1",
+			"kind":       "DockerCluster",
+		},
+	})
+
+	cluster, err := m.Create(&api.Cluster{Name: "a", Region: "eu", Version: "v1.29.0", ControlPlane: &api.ControlPlane{Replicas: ptr.To(int32(1))}})
+	require.NoError(t, err)
+	id := *cluster.Id
+
+	capiCluster, err := client.Resource(CAPIClusterResource).Namespace("malygos").Get(context.Background(), id, metav1.GetOptions{})
+	require.NoError(t, err)
+	ref, _, _ := unstructured.NestedStringMap(capiCluster.Object, "spec", "controlPlaneRef")
+	assert.Equal(t, map[string]string{"apiVersion": "controlplane.cluster.x-k8s.io/v1beta1", "kind": "KubeadmControlPlane",
+		"name": id + "-control-plane"}, ref)
+	infrastructure, _, _ := unstructured.NestedString(capiCluster.Object, "spec", "infrastructureRef", "kind")
+	assert.Equal(t, "DockerCluster", infrastructure)
+
+	controlPlane, err := client.Resource(kubeadmControlPlaneResource).Namespace("malygos").Get(context.Background(), id+"-control-plane", metav1.GetOptions{})
+	require.NoError(t, err)
+	version, _, _ := unstructured.NestedString(controlPlane.Object, "spec", "version")
+	assert.Equal(t, "v1.29.0", version)
+	replicas, _, _ := unstructured.NestedInt64(controlPlane.Object, "spec", "replicas")
+	assert.Equal(t, int64(1), replicas)
+	assert.Equal(t, id, controlPlane.GetOwnerReferences()[0].Name)
+	assert.Equal(t, id, controlPlane.GetLabels()[capiClusterNameLabel])
+
+	_, err = client.Resource(schema.GroupVersionResource{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "dockerclusters"}).
+		Namespace("malygos").Get(context.Background(), id, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	cluster, err = m.Get(id)
+	require.NoError(t, err)
+	assert.Equal(t, "v1.29.0", cluster.Version)
+	assert.Equal(t, int32(1), *cluster.ControlPlane.Replicas)
+
+	reconcile(t, client, id, "v1.29.0")
+
+	// a failed control plane patch leaves the cluster untouched
+	conflicted := false
+	client.PrependReactor("patch", "kubeadmcontrolplanes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if conflicted {
+			return false, nil, nil
+		}
+		conflicted = true
+		return true, nil, k8serrors.NewConflict(kubeadmControlPlaneResource.GroupResource(), controlPlaneName(id), fmt.Errorf("modified"))
+	})
+	_, err = m.Update(id, &api.ClusterUpdate{Version: ptr.To("v1.30.0")})
+	assert.True(t, errors.IsModified(err))
+	capiCluster, err = client.Resource(CAPIClusterResource).Namespace("malygos").Get(context.Background(), id, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "v1.29.0", capiCluster.GetAnnotations()[versionAnnotation])
+
+	cluster, err = m.Update(id, &api.ClusterUpdate{Version: ptr.To("v1.30.0")})
+	require.NoError(t, err)
+	assert.Equal(t, "v1.30.0", cluster.Version)
+	assert.True(t, *cluster.Status.Upgrading)
+
+	controlPlane, err = client.Resource(kubeadmControlPlaneResource).Namespace("malygos").Get(context.Background(), id+"-control-plane", metav1.GetOptions{})
+	require.NoError(t, err)
+	version, _, _ = unstructured.NestedString(controlPlane.Object, "spec", "version")
+	assert.Equal(t, "v1.30.0", version)
+}
+
+func Test_CAPIClusterManager_ConcurrentCreate(t *testing.T) {
+	client := newCAPIClient()
+	m := NewCAPIClusterManager(logr.Discard(), client, "malygos", &CAPITopology{Class: "quick-start"})
+
+	// another creation of the same name lands between the name check and the creation
+	client.PrependReactor("create", "clusters", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		action.(k8stesting.CreateAction).GetObject().(*unstructured.Unstructured).SetCreationTimestamp(metav1.Now())
+
+		rival := buildCAPICluster("malygos-rival", &api.Cluster{Name: "a", Region: "eu", Version: "v1.29.0"}, m.topology)
+		rival.SetNamespace("malygos")
+		rival.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-time.Minute)))
+		if _, err := client.Tracker().Get(CAPIClusterResource, "malygos", rival.GetName()); err != nil {
+			require.NoError(t, client.Tracker().Add(rival))
+		}
+		return false, nil, nil
+	})
+
+	_, err := m.Create(&api.Cluster{Name: "a", Region: "eu", Version: "v1.29.0"})
+	assert.True(t, errors.IsConflict(err))
+
+	clusters, _, err := m.List(api.ClusterListOptions{})
+	require.NoError(t, err)
+	require.Len(t, clusters, 1, "the losing cluster is deleted")
+	assert.Equal(t, "malygos-rival", *clusters[0].Id)
+}
+
+func Test_CAPIClusterStatus(t *testing.T) {
+	capiCluster := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{
+			"phase":          "Failed",
+			"failureMessage": "quota exceeded",
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "InfrastructureReady", "status": "False", "reason": "QuotaExceeded", "message": "no quota"},
+			},
+		},
+	}}
+
+	status := capiToAPIClusterStatus(capiCluster, nil, "v1.29.0")
+	assert.Equal(t, "Failed", status.Phase)
+	assert.False(t, status.Online)
+	assert.Nil(t, status.Version)
+	assert.Nil(t, status.Replicas)
+	conditions := *status.Conditions
+	assert.Equal(t, "Ready", conditions[0].Type)
+	assert.Equal(t, api.False, conditions[0].Status)
+	assert.Equal(t, "quota exceeded", *conditions[0].Message)
+	assert.Equal(t, "ClusterInfrastructureReady", conditions[4].Type)
+	assert.Equal(t, "QuotaExceeded", conditions[4].Reason)
+
+	// a provisioned cluster without endpoint can't be used
+	capiCluster.Object["status"] = map[string]interface{}{"phase": "Provisioned", "controlPlaneReady": true}
+	status = capiToAPIClusterStatus(capiCluster, nil, "v1.29.0")
+	assert.False(t, status.Online)
+	assert.Equal(t, "Unreachable", (*status.Conditions)[0].Reason)
+	assert.True(t, *status.KubeconfigReady)
+
+	// an upgrade held back by the topology controller
+	capiCluster.Object["status"] = map[string]interface{}{
+		"phase":             "Provisioned",
+		"controlPlaneReady": true,
+		"conditions": []interface{}{
+			map[string]interface{}{"type": "TopologyReconciled", "status": "False", "reason": "ControlPlaneUpgradePending"},
+		},
+	}
+	capiCluster.Object["spec"] = map[string]interface{}{"controlPlaneEndpoint": map[string]interface{}{"host": "api.example.com", "port": int64(443)}}
+	controlPlane := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec":   map[string]interface{}{"replicas": int64(3)},
+		"status": map[string]interface{}{"version": "v1.29.0", "replicas": int64(3), "unavailableReplicas": int64(1)},
+	}}
+	status = capiToAPIClusterStatus(capiCluster, controlPlane, "v1.29.0")
+	assert.True(t, status.Online)
+	assert.Equal(t, "Upgrading", status.Phase)
+	assert.True(t, *status.Upgrading)
+	assert.Equal(t, "api.example.com:443", *status.Endpoint)
+	assert.Equal(t, int32(2), status.Replicas.Available)
+}
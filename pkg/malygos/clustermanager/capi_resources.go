@@ -0,0 +1,45 @@
+package clustermanager
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// capiResources maps the control plane and infrastructure kinds of the common Cluster API providers
+// to their resource, the regions using other providers declare them in their topology.
+var capiResources = map[schema.GroupKind]string{
+	{Group: "controlplane.cluster.x-k8s.io", Kind: "KubeadmControlPlane"}:   "kubeadmcontrolplanes",
+	{Group: "controlplane.cluster.x-k8s.io", Kind: "KamajiControlPlane"}:    "kamajicontrolplanes",
+	{Group: "controlplane.cluster.x-k8s.io", Kind: "K0smotronControlPlane"}: "k0smotroncontrolplanes",
+	{Group: "controlplane.cluster.x-k8s.io", Kind: "K0sControlPlane"}:       "k0scontrolplanes",
+	{Group: "controlplane.cluster.x-k8s.io", Kind: "RKE2ControlPlane"}:      "rke2controlplanes",
+	{Group: "controlplane.cluster.x-k8s.io", Kind: "TalosControlPlane"}:     "taloscontrolplanes",
+	{Group: "infrastructure.cluster.x-k8s.io", Kind: "DockerCluster"}:       "dockerclusters",
+	{Group: "infrastructure.cluster.x-k8s.io", Kind: "AWSCluster"}:          "awsclusters",
+	{Group: "infrastructure.cluster.x-k8s.io", Kind: "AzureCluster"}:        "azureclusters",
+	{Group: "infrastructure.cluster.x-k8s.io", Kind: "GCPCluster"}:          "gcpclusters",
+	{Group: "infrastructure.cluster.x-k8s.io", Kind: "OpenStackCluster"}:    "openstackclusters",
+	{Group: "infrastructure.cluster.x-k8s.io", Kind: "VSphereCluster"}:      "vsphereclusters",
+	{Group: "infrastructure.cluster.x-k8s.io", Kind: "Metal3Cluster"}:       "metal3clusters",
+	{Group: "infrastructure.cluster.x-k8s.io", Kind: "KubevirtCluster"}:     "kubevirtclusters",
+}
+
+// capiResource returns the resource of a kind referenced by a Cluster API cluster, the resources
+// of the topology take precedence over the built-in ones.
+func capiResource(topology *CAPITopology, apiVersion string, kind string) (schema.GroupVersionResource, error) {
+	gvk := schema.FromAPIVersionAndKind(apiVersion, kind)
+	resource, ok := capiResources[gvk.GroupKind()]
+	if topology != nil {
+		if declared, found := topology.Resources[kind]; found {
+			resource, ok = declared, true
+		}
+	}
+
+	if !ok || resource == "" {
+		return schema.GroupVersionResource{}, fmt.Errorf("resource of cluster API kind %s is unknown, it must be declared in the resources of the region topology",
+			gvk.GroupKind())
+	}
+
+	return gvk.GroupVersion().WithResource(resource), nil
+}
@@ -0,0 +1,157 @@
+package clustermanager
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+)
+
+// Cluster API cluster phases, the others are reported as is
+const (
+	capiPhaseProvisioned = "Provisioned"
+	capiPhaseFailed      = "Failed"
+)
+
+// capiToAPIClusterStatus translates the status Cluster API reports on a cluster and its control plane,
+// which is nil when unknown
+func capiToAPIClusterStatus(capiCluster *unstructured.Unstructured, controlPlane *unstructured.Unstructured, version string) *api.ClusterStatus {
+	phase, _, _ := unstructured.NestedString(capiCluster.Object, "status", "phase")
+	if phase == "" {
+		phase = "Pending"
+	}
+
+	controlPlaneReady, _, _ := unstructured.NestedBool(capiCluster.Object, "status", "controlPlaneReady")
+	conditions := capiConditions(capiCluster)
+	initialized := controlPlaneReady || conditionTrue(conditions, "ControlPlaneInitialized")
+
+	status := &api.ClusterStatus{
+		Phase:           phase,
+		KubeconfigReady: ptr.To(initialized),
+	}
+
+	host, _, _ := unstructured.NestedString(capiCluster.Object, "spec", "controlPlaneEndpoint", "host")
+	port, _, _ := unstructured.NestedInt64(capiCluster.Object, "spec", "controlPlaneEndpoint", "port")
+	if host != "" {
+		status.Endpoint = ptr.To(net.JoinHostPort(host, strconv.FormatInt(port, 10)))
+	}
+
+	if port != 0 {
+		status.Port = ptr.To(int32(port))
+	}
+
+	available := controlPlaneReady
+	if controlPlane != nil {
+		desired, _, _ := unstructured.NestedInt64(controlPlane.Object, "spec", "replicas")
+		replicas, _, _ := unstructured.NestedInt64(controlPlane.Object, "status", "replicas")
+		ready, _, _ := unstructured.NestedInt64(controlPlane.Object, "status", "readyReplicas")
+		updated, _, _ := unstructured.NestedInt64(controlPlane.Object, "status", "updatedReplicas")
+		unavailable, _, _ := unstructured.NestedInt64(controlPlane.Object, "status", "unavailableReplicas")
+		status.Replicas = &api.ReplicasStatus{
+			Desired:   int32(desired),
+			Ready:     int32(ready),
+			Available: int32(replicas - unavailable),
+			Updated:   int32(updated),
+		}
+		available = status.Replicas.Available > 0
+
+		// the control plane reports the running version, which lags behind the spec until an upgrade completes
+		if current, _, _ := unstructured.NestedString(controlPlane.Object, "status", "version"); current != "" {
+			status.Version = ptr.To(current)
+			status.Upgrading = ptr.To(current != version || topologyUpgrading(conditions))
+		}
+	}
+
+	endpointReady := condition("EndpointReady", status.Endpoint != nil, "EndpointAssigned", "EndpointPending", time.Time{})
+	kubeconfigReady := condition("KubeconfigReady", initialized, "KubeconfigGenerated", "KubeconfigPending", time.Time{})
+	controlPlaneAvailable := condition("ControlPlaneAvailable", available, "ReplicasAvailable", "NoReplicaAvailable", time.Time{})
+	if status.Replicas != nil {
+		controlPlaneAvailable.Message = ptr.To(fmt.Sprintf("%d/%d replicas available", status.Replicas.Available, status.Replicas.Desired))
+	}
+
+	// the cluster is online once Cluster API provisioned it and it can actually be reached
+	status.Online = phase == capiPhaseProvisioned && controlPlaneReady && status.Endpoint != nil
+	if status.Online {
+		status.Phase = "Ready"
+		if status.Upgrading != nil && *status.Upgrading {
+			status.Phase = "Upgrading"
+		}
+	}
+
+	ready := condition("Ready", status.Online, status.Phase, status.Phase, time.Time{})
+	if !status.Online && phase == capiPhaseProvisioned {
+		ready.Reason = "Unreachable"
+	}
+
+	if message, _, _ := unstructured.NestedString(capiCluster.Object, "status", "failureMessage"); message != "" {
+		ready.Message = ptr.To(message)
+	} else if phase == capiPhaseFailed {
+		ready.Message = ptr.To("cluster API reported a failure")
+	}
+
+	all := []api.ClusterCondition{ready, endpointReady, kubeconfigReady, controlPlaneAvailable}
+	for _, c := range conditions {
+		c.Type = "Cluster" + c.Type
+		all = append(all, c)
+	}
+	status.Conditions = &all
+
+	return status
+}
+
+// capiConditions reads the conditions of a Cluster API object
+func capiConditions(obj *unstructured.Unstructured) []api.ClusterCondition {
+	items, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	conditions := []api.ClusterCondition{}
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		c := api.ClusterCondition{}
+		c.Type, _, _ = unstructured.NestedString(fields, "type")
+		c.Reason, _, _ = unstructured.NestedString(fields, "reason")
+		conditionStatus, _, _ := unstructured.NestedString(fields, "status")
+		c.Status = api.ClusterConditionStatus(conditionStatus)
+
+		if message, _, _ := unstructured.NestedString(fields, "message"); message != "" {
+			c.Message = ptr.To(message)
+		}
+
+		transition, _, _ := unstructured.NestedString(fields, "lastTransitionTime")
+		if t, err := time.Parse(time.RFC3339, transition); err == nil {
+			c.LastTransitionTime = ptr.To(t)
+		}
+
+		conditions = append(conditions, c)
+	}
+
+	return conditions
+}
+
+func conditionTrue(conditions []api.ClusterCondition, conditionType string) bool {
+	for _, c := range conditions {
+		if c.Type == conditionType {
+			return c.Status == api.True
+		}
+	}
+
+	return false
+}
+
+// topologyUpgrading tells whether Cluster API holds back the rollout of a new topology version
+func topologyUpgrading(conditions []api.ClusterCondition) bool {
+	for _, c := range conditions {
+		if c.Type == "TopologyReconciled" && c.Status != api.True && strings.Contains(c.Reason, "UpgradePending") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -2,8 +2,12 @@ package clustermanager
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	kamaji "github.com/clastix/kamaji/api/v1alpha1"
 	"github.com/go-logr/logr"
@@ -14,23 +18,39 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/utils/ptr"
 )
 
 const (
 	regionalClusterLabel    = "malygos.local/region"
 	clusterIDLabel          = "malygos.local/cluster-id"
+	teamLabel               = "malygos.local/team"
+	ownerAnnotation         = "malygos.local/owner"
+	nameAnnotation          = "malygos.local/name"
 	clusterRandomNameLength = 10
+	adminKubeconfigKey      = "admin.conf"
+	// controlPlaneLabel is set by Kamaji on the resources it generates for a TenantControlPlane
+	controlPlaneLabel    = "kamaji.clastix.io/name"
+	deletionPollInterval = 2 * time.Second
 )
 
+// TenantControlPlaneResource is the resource kamaji clusters are stored as on registrars.
+var TenantControlPlaneResource = kamaji.GroupVersion.WithResource("tenantcontrolplanes")
+
+var secretsResource = v1.SchemeGroupVersion.WithResource("secrets")
+
 type KamajiClusterManager struct {
-	client    *dynamic.DynamicClient
+	client    dynamic.Interface
 	logger    logr.Logger
 	namespace string
 }
 
-func NewKamajiClusterManager(logger logr.Logger, client *dynamic.DynamicClient, namespace string) *KamajiClusterManager {
+func NewKamajiClusterManager(logger logr.Logger, client dynamic.Interface, namespace string) *KamajiClusterManager {
 	return &KamajiClusterManager{
 		client:    client,
 		logger:    logger,
@@ -43,32 +63,112 @@ func generateClusterID() string {
 }
 
 func (m *KamajiClusterManager) Create(cluster *api.Cluster) (*api.Cluster, error) {
+	owner, err := m.nameOwner(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if owner != "" {
+		return nil, errors.NewConflictError("cluster", cluster.Name)
+	}
+
 	clusterID := generateClusterID()
+	kamajiCluster, err := buildTenantControlPlane(clusterID, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(kamajiCluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal kamaji cluster: %v", err)
+	}
+
+	unstructuredObj := &unstructured.Unstructured{}
+	err = unstructuredObj.UnmarshalJSON(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal kamaji cluster: %v", err)
+	}
+
+	_, err = m.client.Resource(TenantControlPlaneResource).
+		Namespace(m.namespace).
+		Create(context.TODO(), unstructuredObj, metav1.CreateOptions{})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kamaji cluster: %v", err)
+	}
+
+	// concurrent creations with the same name all keep the oldest cluster
+	owner, err = m.nameOwner(cluster)
+	if err != nil || owner != clusterID {
+		if deleteErr := m.Delete(clusterID); deleteErr != nil {
+			m.logger.Error(deleteErr, "failed to delete duplicate kamaji cluster", "id", clusterID)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.NewConflictError("cluster", cluster.Name)
+	}
+
+	cluster.Id = &clusterID
+	cluster.Status = &api.ClusterStatus{
+		Phase:  "Pending",
+		Online: false,
+	}
+
+	return cluster, nil
+}
+
+// nameOwner returns the id of the oldest cluster of the region named like cluster, empty when there is none
+func (m *KamajiClusterManager) nameOwner(cluster *api.Cluster) (string, error) {
+	clusters, err := m.list(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", regionalClusterLabel, cluster.Region),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	objects := make([]metav1.Object, 0, len(clusters))
+	for i := range clusters {
+		objects = append(objects, &clusters[i])
+	}
+
+	return oldestNamed(objects, cluster.Name), nil
+}
+
+func buildTenantControlPlane(clusterID string, cluster *api.Cluster) (*kamaji.TenantControlPlane, error) {
+	// the API already resolved the control plane against the region policy, applying the built-in
+	// defaults again is a no-op that keeps callers passing a partial control plane working
+	var policy *api.ControlPlanePolicy
+	controlPlane, err := policy.Apply(cluster.ControlPlane, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, annotations := clusterMetadata(cluster)
 	kamajiCluster := &kamaji.TenantControlPlane{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: kamaji.GroupVersion.String(),
 			Kind:       "TenantControlPlane",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: clusterID,
-			Labels: map[string]string{
-				regionalClusterLabel: cluster.Region,
-			},
+			Name:        clusterID,
+			Labels:      labels,
+			Annotations: annotations,
 		},
 		Spec: kamaji.TenantControlPlaneSpec{
-			DataStore: "default",
+			DataStore: *controlPlane.Datastore,
 			ControlPlane: kamaji.ControlPlane{
 				Deployment: kamaji.DeploymentSpec{
-					Replicas: ptr.To(int32(3)),
+					Replicas: controlPlane.Replicas,
 					Resources: &kamaji.ControlPlaneComponentsResources{
-						APIServer:         &v1.ResourceRequirements{},
-						ControllerManager: &v1.ResourceRequirements{},
-						Scheduler:         &v1.ResourceRequirements{},
-						Kine:              &v1.ResourceRequirements{},
+						APIServer:         ptr.To(controlPlane.Resources.Apiserver.ResourceRequirements()),
+						ControllerManager: ptr.To(controlPlane.Resources.ControllerManager.ResourceRequirements()),
+						Scheduler:         ptr.To(controlPlane.Resources.Scheduler.ResourceRequirements()),
+						Kine:              ptr.To(controlPlane.Resources.Kine.ResourceRequirements()),
 					},
 				},
 				Service: kamaji.ServiceSpec{
-					ServiceType: "ClusterIP",
+					ServiceType: kamaji.ServiceType(*controlPlane.ServiceType),
 					AdditionalMetadata: kamaji.AdditionalMetadata{
 						Labels: map[string]string{
 							clusterIDLabel:       clusterID,
@@ -83,57 +183,286 @@ func (m *KamajiClusterManager) Create(cluster *api.Cluster) (*api.Cluster, error
 					CGroupFS: "systemd",
 				},
 			},
-			NetworkProfile: kamaji.NetworkProfileSpec{},
-			Addons: kamaji.AddonsSpec{
-				CoreDNS: &kamaji.AddonSpec{},
-			},
+			NetworkProfile: buildNetworkProfile(cluster.Network),
+			Addons:         buildAddons(cluster.Addons),
 		},
 	}
 
-	b, err := json.Marshal(kamajiCluster)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal kamaji cluster: %v", err)
+	return kamajiCluster, nil
+}
+
+// buildNetworkProfile maps a validated network, kamaji defaults the fields left empty
+func buildNetworkProfile(network *api.Network) kamaji.NetworkProfileSpec {
+	profile := kamaji.NetworkProfileSpec{}
+	if network == nil {
+		return profile
 	}
 
-	unstructuredObj := &unstructured.Unstructured{}
-	err = unstructuredObj.UnmarshalJSON(b)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal kamaji cluster: %v", err)
+	profile.Address = ptr.Deref(network.Address, "")
+	profile.Port = ptr.Deref(network.Port, 0)
+	profile.PodCIDR = ptr.Deref(network.PodCidr, "")
+	profile.ServiceCIDR = ptr.Deref(network.ServiceCidr, "")
+
+	if network.CertSans != nil {
+		profile.CertSANs = *network.CertSans
 	}
 
-	_, err = m.client.Resource(kamaji.GroupVersion.WithResource("tenantcontrolplanes")).
-		Namespace(m.namespace).
-		Create(context.TODO(), unstructuredObj, metav1.CreateOptions{})
+	if network.DnsServiceIps != nil && len(*network.DnsServiceIps) > 0 {
+		profile.DNSServiceIPs = *network.DnsServiceIps
+	} else if profile.ServiceCIDR != "" {
+		// kamaji's default DNS address only fits its default service CIDR
+		if address, err := api.DefaultDNSServiceIP(profile.ServiceCIDR); err == nil {
+			profile.DNSServiceIPs = []string{address}
+		}
+	}
 
-	if err != nil {
-		return nil, fmt.Errorf("failed to create kamaji cluster: %v", err)
+	return profile
+}
+
+// buildAddons maps validated addons, the default addons are used when they are not set
+func buildAddons(addons *api.Addons) kamaji.AddonsSpec {
+	if addons == nil {
+		addons = api.DefaultAddons()
 	}
 
-	cluster.Id = &clusterID
-	cluster.Status = &api.ClusterStatus{
-		Phase:  "Pending",
-		Online: false,
+	spec := kamaji.AddonsSpec{}
+	if addons.Coredns != nil && addons.Coredns.Enabled {
+		spec.CoreDNS = buildAddon(addons.Coredns)
 	}
 
-	return cluster, nil
+	if addons.KubeProxy != nil && addons.KubeProxy.Enabled {
+		spec.KubeProxy = buildAddon(addons.KubeProxy)
+	}
+
+	if addons.Konnectivity != nil && addons.Konnectivity.Enabled {
+		spec.Konnectivity = buildKonnectivity(addons.Konnectivity)
+	}
+
+	return spec
+}
+
+func buildAddon(addon *api.Addon) *kamaji.AddonSpec {
+	return &kamaji.AddonSpec{
+		ImageOverrideTrait: kamaji.ImageOverrideTrait{
+			ImageRepository: ptr.Deref(addon.ImageRepository, ""),
+			ImageTag:        ptr.Deref(addon.ImageTag, ""),
+		},
+	}
+}
+
+func buildKonnectivity(addon *api.KonnectivityAddon) *kamaji.KonnectivitySpec {
+	// the server port is required once the server is set, so the CRD default doesn't apply
+	spec := &kamaji.KonnectivitySpec{
+		KonnectivityServerSpec: kamaji.KonnectivityServerSpec{
+			Port:    ptr.Deref(addon.ServerPort, api.DefaultKonnectivityServerPort),
+			Version: ptr.Deref(addon.Version, ""),
+		},
+	}
+
+	if addon.Version != nil {
+		spec.KonnectivityAgentSpec.Version = *addon.Version
+	}
+
+	return spec
+}
+
+// addonsPatch returns the merge patch of the addons set in addons, a null value disables an addon
+// and the settings malygos manages are all sent so that unset ones go back to their default
+func addonsPatch(addons *api.Addons) map[string]interface{} {
+	patch := map[string]interface{}{}
+	if addons.Coredns != nil {
+		patch["coreDNS"] = nil
+		if addons.Coredns.Enabled {
+			patch["coreDNS"] = addonPatch(addons.Coredns)
+		}
+	}
+
+	if addons.KubeProxy != nil {
+		patch["kubeProxy"] = nil
+		if addons.KubeProxy.Enabled {
+			patch["kubeProxy"] = addonPatch(addons.KubeProxy)
+		}
+	}
+
+	if addons.Konnectivity != nil {
+		patch["konnectivity"] = nil
+		if addons.Konnectivity.Enabled {
+			spec := buildKonnectivity(addons.Konnectivity)
+			patch["konnectivity"] = map[string]interface{}{
+				"server": map[string]interface{}{
+					"port":    spec.KonnectivityServerSpec.Port,
+					"version": addons.Konnectivity.Version,
+				},
+				"agent": map[string]interface{}{
+					"version": addons.Konnectivity.Version,
+				},
+			}
+		}
+	}
+
+	return patch
+}
+
+func addonPatch(addon *api.Addon) map[string]interface{} {
+	return map[string]interface{}{
+		"imageRepository": addon.ImageRepository,
+		"imageTag":        addon.ImageTag,
+	}
+}
+
+func toAPIAddons(spec *kamaji.AddonsSpec) *api.Addons {
+	addons := &api.Addons{
+		Coredns:      toAPIAddon(spec.CoreDNS),
+		KubeProxy:    toAPIAddon(spec.KubeProxy),
+		Konnectivity: &api.KonnectivityAddon{Enabled: spec.Konnectivity != nil},
+	}
+
+	if spec.Konnectivity != nil {
+		addons.Konnectivity.ServerPort = ptr.To(spec.Konnectivity.KonnectivityServerSpec.Port)
+		if version := spec.Konnectivity.KonnectivityServerSpec.Version; version != "" {
+			addons.Konnectivity.Version = ptr.To(version)
+		}
+	}
+
+	return addons
+}
+
+func toAPIAddon(spec *kamaji.AddonSpec) *api.Addon {
+	addon := &api.Addon{Enabled: spec != nil}
+	if spec == nil {
+		return addon
+	}
+
+	if spec.ImageRepository != "" {
+		addon.ImageRepository = ptr.To(spec.ImageRepository)
+	}
+
+	if spec.ImageTag != "" {
+		addon.ImageTag = ptr.To(spec.ImageTag)
+	}
+
+	return addon
+}
+
+func toAPINetwork(profile *kamaji.NetworkProfileSpec) *api.Network {
+	network := &api.Network{}
+	if profile.Address != "" {
+		network.Address = ptr.To(profile.Address)
+	}
+
+	if profile.Port != 0 {
+		network.Port = ptr.To(profile.Port)
+	}
+
+	if len(profile.CertSANs) > 0 {
+		network.CertSans = ptr.To(profile.CertSANs)
+	}
+
+	if profile.PodCIDR != "" {
+		network.PodCidr = ptr.To(profile.PodCIDR)
+	}
+
+	if profile.ServiceCIDR != "" {
+		network.ServiceCidr = ptr.To(profile.ServiceCIDR)
+	}
+
+	if len(profile.DNSServiceIPs) > 0 {
+		network.DnsServiceIps = ptr.To(profile.DNSServiceIPs)
+	}
+
+	return network
 }
 
 func (m *KamajiClusterManager) Delete(id string) error {
-	err := m.client.Resource(kamaji.GroupVersion.WithResource("tenantcontrolplanes")).
+	err := m.client.Resource(TenantControlPlaneResource).
 		Namespace(m.namespace).
 		Delete(context.TODO(), id, metav1.DeleteOptions{})
 	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return errors.NewNotFoundError("kamaji cluster", id)
+		}
 		return fmt.Errorf("failed to delete kamaji cluster: %v", err)
 	}
 	return nil
 }
 
-func (m *KamajiClusterManager) List() ([]*api.Cluster, error) {
-	unstructuredList, err := m.client.Resource(kamaji.GroupVersion.WithResource("tenantcontrolplanes")).
+// WaitForDeletion blocks until the TenantControlPlane and the secrets Kamaji generated for it are removed
+func (m *KamajiClusterManager) WaitForDeletion(ctx context.Context, id string) error {
+	return wait.PollUntilContextCancel(ctx, deletionPollInterval, true, func(ctx context.Context) (bool, error) {
+		_, err := m.client.Resource(TenantControlPlaneResource).
+			Namespace(m.namespace).
+			Get(ctx, id, metav1.GetOptions{})
+		if err == nil {
+			return false, nil
+		}
+
+		// transient errors are retried until the deadline
+		if !k8serrors.IsNotFound(err) {
+			m.logger.Error(err, "failed to get kamaji cluster", "id", id)
+			return false, nil
+		}
+
+		secrets, err := m.client.Resource(secretsResource).
+			Namespace(m.namespace).
+			List(ctx, metav1.ListOptions{LabelSelector: controlPlaneLabel + "=" + id})
+		if err != nil {
+			m.logger.Error(err, "failed to list kamaji cluster secrets", "id", id)
+			return false, nil
+		}
+
+		return len(secrets.Items) == 0, nil
+	})
+}
+
+func (m *KamajiClusterManager) List(options api.ClusterListOptions) ([]*api.Cluster, string, error) {
+	listOptions := metav1.ListOptions{
+		Limit:    options.Limit,
+		Continue: options.Continue,
+	}
+	if options.LabelSelector != "" {
+		selector, err := api.UserLabelSelector(options.LabelSelector)
+		if err != nil {
+			return nil, "", err
+		}
+		listOptions.LabelSelector = selector.String()
+	}
+
+	kamajiClusters, err := m.listPage(listOptions)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// the API server returns objects in name order, sorting only matters for other backends
+	slices.SortFunc(kamajiClusters.Items, func(a, b kamaji.TenantControlPlane) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	clusters := make([]*api.Cluster, 0)
+	for _, kc := range kamajiClusters.Items {
+		clusters = append(clusters, toAPICluster(&kc))
+	}
+
+	return clusters, kamajiClusters.Continue, nil
+}
+
+func (m *KamajiClusterManager) list(options metav1.ListOptions) ([]kamaji.TenantControlPlane, error) {
+	kamajiClusters, err := m.listPage(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return kamajiClusters.Items, nil
+}
+
+func (m *KamajiClusterManager) listPage(options metav1.ListOptions) (*kamaji.TenantControlPlaneList, error) {
+	unstructuredList, err := m.client.Resource(TenantControlPlaneResource).
 		Namespace(m.namespace).
-		List(context.TODO(), metav1.ListOptions{})
+		List(context.TODO(), options)
 
 	if err != nil {
+		if options.Continue != "" && (k8serrors.IsResourceExpired(err) || k8serrors.IsBadRequest(err)) {
+			return nil, errors.NewInvalidArgumentError("continue token is expired or invalid, the list must be restarted")
+		}
 		return nil, fmt.Errorf("failed to list kamaji clusters: %v", err)
 	}
 
@@ -148,37 +477,20 @@ func (m *KamajiClusterManager) List() ([]*api.Cluster, error) {
 		return nil, fmt.Errorf("failed to unmarshal kamaji cluster list: %v", err)
 	}
 
-	clusters := make([]*api.Cluster, 0)
-	for _, kc := range kamajiClusters.Items {
-		status := "Pending"
-		if kc.Status.Kubernetes.Version.Status != nil {
-			status = string(*kc.Status.Kubernetes.Version.Status)
-		}
-
-		region := ""
-		if val, ok := kc.Labels[regionalClusterLabel]; ok {
-			region = val
-		} else {
-			region = "unknown"
-		}
+	return &kamajiClusters, nil
+}
 
-		cluster := &api.Cluster{
-			Id:     ptr.To(kc.Name),
-			Name:   "", // TODO
-			Region: region,
-			Status: &api.ClusterStatus{
-				Phase:  status,
-				Online: status == "Ready",
-			},
-		}
-		clusters = append(clusters, cluster)
+func (m *KamajiClusterManager) Get(id string) (*api.Cluster, error) {
+	kamajiCluster, err := m.get(id)
+	if err != nil {
+		return nil, err
 	}
 
-	return clusters, nil
+	return toAPICluster(kamajiCluster), nil
 }
 
-func (m *KamajiClusterManager) Get(id string) (*api.Cluster, error) {
-	unstructuredObj, err := m.client.Resource(kamaji.GroupVersion.WithResource("tenantcontrolplanes")).
+func (m *KamajiClusterManager) get(id string) (*kamaji.TenantControlPlane, error) {
+	unstructuredObj, err := m.client.Resource(TenantControlPlaneResource).
 		Namespace(m.namespace).
 		Get(context.TODO(), id, metav1.GetOptions{})
 	if err != nil {
@@ -199,27 +511,265 @@ func (m *KamajiClusterManager) Get(id string) (*api.Cluster, error) {
 		return nil, fmt.Errorf("failed to unmarshal kamaji cluster: %v", err)
 	}
 
-	region := ""
-	if val, ok := kamajiCluster.Labels[regionalClusterLabel]; ok {
-		region = val
-	} else {
-		region = "unknown"
+	return &kamajiCluster, nil
+}
+
+func (m *KamajiClusterManager) GetKubeconfig(id string) (string, error) {
+	kamajiCluster, err := m.get(id)
+	if err != nil {
+		return "", err
+	}
+
+	status := kamajiCluster.Status.Kubernetes.Version.Status
+	if status == nil || *status != kamaji.VersionReady || kamajiCluster.Status.ControlPlaneEndpoint == "" {
+		return "", errors.NewNotReadyError("cluster", id)
+	}
+
+	secretName := kamajiCluster.Status.KubeConfig.Admin.SecretName
+	if secretName == "" {
+		secretName = fmt.Sprintf("%s-admin-kubeconfig", kamajiCluster.Name)
 	}
 
-	status := "Pending"
-	if kamajiCluster.Status.Kubernetes.Version.Status != nil {
-		status = string(*kamajiCluster.Status.Kubernetes.Version.Status)
+	secret, err := m.client.Resource(secretsResource).
+		Namespace(m.namespace).
+		Get(context.TODO(), secretName, metav1.GetOptions{})
+	if err != nil {
+		// kamaji writes the secret shortly after the control plane is up
+		if k8serrors.IsNotFound(err) {
+			return "", errors.NewNotReadyError("cluster", id)
+		}
+		return "", fmt.Errorf("failed to get admin kubeconfig secret: %v", err)
+	}
+
+	encoded, found, err := unstructured.NestedString(secret.Object, "data", adminKubeconfigKey)
+	if err != nil || !found {
+		return "", errors.NewNotReadyError("cluster", id)
 	}
 
-	return &api.Cluster{
-		Id:     ptr.To(kamajiCluster.Name),
-		Name:   "", // TODO
-		Region: region,
-		Status: &api.ClusterStatus{
-			Phase:  status,
-			Online: status == "Ready",
+	b, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode admin kubeconfig: %v", err)
+	}
+
+	return rewriteKubeconfigServer(b, kamajiCluster.Status.ControlPlaneEndpoint)
+}
+
+// rewriteKubeconfigServer points every cluster of the kubeconfig to endpoint, kamaji generates
+// kubeconfigs using the in cluster service address which is not reachable by users
+func rewriteKubeconfigServer(kubeconfig []byte, endpoint string) (string, error) {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse admin kubeconfig: %v", err)
+	}
+
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+
+	for _, cluster := range config.Clusters {
+		cluster.Server = endpoint
+	}
+
+	b, err := clientcmd.Write(*config)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize admin kubeconfig: %v", err)
+	}
+
+	return string(b), nil
+}
+
+func (m *KamajiClusterManager) SetOwnership(id string, owner string, team string) error {
+	patch, err := ownershipPatch(owner, team)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.client.Resource(TenantControlPlaneResource).
+		Namespace(m.namespace).
+		Patch(context.TODO(), id, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return errors.NewNotFoundError("kamaji cluster", id)
+		}
+		return fmt.Errorf("failed to update kamaji cluster ownership: %v", err)
+	}
+
+	return nil
+}
+
+func (m *KamajiClusterManager) Update(id string, update *api.ClusterUpdate) (*api.Cluster, error) {
+	kamajiCluster, err := m.get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := map[string]interface{}{}
+	if update.Version != nil && *update.Version != kamajiCluster.Spec.Kubernetes.Version {
+		// kamaji upgrades the control plane in place, stacking upgrades would skip minor versions
+		status := kamajiCluster.Status.Kubernetes.Version
+		if status.Status == nil || *status.Status != kamaji.VersionReady || status.Version != kamajiCluster.Spec.Kubernetes.Version {
+			return nil, errors.NewNotReadyError("cluster", id)
+		}
+
+		spec["kubernetes"] = map[string]interface{}{
+			"version": *update.Version,
+		}
+	}
+
+	if update.Addons != nil {
+		if addons := addonsPatch(update.Addons); len(addons) > 0 {
+			spec["addons"] = addons
+		}
+	}
+
+	if len(spec) == 0 {
+		return toAPICluster(kamajiCluster), nil
+	}
+
+	// the resource version makes the patch fail if the cluster changed since it was validated
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": kamajiCluster.ResourceVersion,
 		},
-	}, nil
+		"spec": spec,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal update patch: %v", err)
+	}
+
+	unstructuredObj, err := m.client.Resource(TenantControlPlaneResource).
+		Namespace(m.namespace).
+		Patch(context.TODO(), id, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, errors.NewNotFoundError("kamaji cluster", id)
+		}
+		if k8serrors.IsConflict(err) {
+			return nil, errors.NewModifiedError("cluster", id)
+		}
+		return nil, fmt.Errorf("failed to update kamaji cluster: %v", err)
+	}
+
+	b, err := json.Marshal(unstructuredObj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal kamaji cluster: %v", err)
+	}
+
+	updated := &kamaji.TenantControlPlane{}
+	if err := json.Unmarshal(b, updated); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal kamaji cluster: %v", err)
+	}
+
+	return toAPICluster(updated), nil
+}
+
+func (m *KamajiClusterManager) Usage(team string) (*api.ResourceUsage, error) {
+	// team-less clusters count against their owner, which can't be selected by label
+	kamajiClusters, err := m.list(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &api.ResourceUsage{}
+	for _, kc := range kamajiClusters {
+		if team != "" && quotaTeam(kc.Labels, kc.Annotations) != team {
+			continue
+		}
+
+		usage.Add(tenantControlPlaneFootprint(&kc))
+	}
+
+	return usage, nil
+}
+
+func (m *KamajiClusterManager) Footprint(cluster *api.Cluster) (*api.ResourceUsage, error) {
+	kamajiCluster, err := buildTenantControlPlane("", cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return tenantControlPlaneFootprint(kamajiCluster), nil
+}
+
+// tenantControlPlaneFootprint sums the requests of the control plane components over every replica
+func tenantControlPlaneFootprint(kamajiCluster *kamaji.TenantControlPlane) *api.ResourceUsage {
+	deployment := kamajiCluster.Spec.ControlPlane.Deployment
+	replicas := int64(1)
+	if deployment.Replicas != nil {
+		replicas = int64(*deployment.Replicas)
+	}
+
+	usage := &api.ResourceUsage{
+		Clusters: 1,
+		Replicas: replicas,
+	}
+
+	if deployment.Resources == nil {
+		return usage
+	}
+
+	components := []*v1.ResourceRequirements{
+		deployment.Resources.APIServer,
+		deployment.Resources.ControllerManager,
+		deployment.Resources.Scheduler,
+		deployment.Resources.Kine,
+	}
+
+	for _, component := range components {
+		if component == nil {
+			continue
+		}
+
+		cpu := component.Requests.Cpu().DeepCopy()
+		cpu.Mul(replicas)
+		usage.CPU.Add(cpu)
+
+		memory := component.Requests.Memory().DeepCopy()
+		memory.Mul(replicas)
+		usage.Memory.Add(memory)
+	}
+
+	return usage
+}
+
+// UnstructuredToAPICluster converts a TenantControlPlane read through a dynamic client.
+func UnstructuredToAPICluster(obj *unstructured.Unstructured) (*api.Cluster, error) {
+	kamajiCluster := &kamaji.TenantControlPlane{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, kamajiCluster); err != nil {
+		return nil, fmt.Errorf("failed to convert kamaji cluster: %v", err)
+	}
+
+	return toAPICluster(kamajiCluster), nil
+}
+
+func toAPICluster(kamajiCluster *kamaji.TenantControlPlane) *api.Cluster {
+	cluster := &api.Cluster{
+		Id:      ptr.To(kamajiCluster.Name),
+		Version: kamajiCluster.Spec.Kubernetes.Version,
+		Status:  toAPIClusterStatus(kamajiCluster),
+	}
+	setClusterMetadata(cluster, kamajiCluster.Labels, kamajiCluster.Annotations)
+
+	cluster.Network = toAPINetwork(&kamajiCluster.Spec.NetworkProfile)
+	cluster.Addons = toAPIAddons(&kamajiCluster.Spec.Addons)
+
+	deployment := kamajiCluster.Spec.ControlPlane.Deployment
+	cluster.ControlPlane = &api.ControlPlane{
+		Replicas:    deployment.Replicas,
+		ServiceType: ptr.To(api.ControlPlaneServiceType(kamajiCluster.Spec.ControlPlane.Service.ServiceType)),
+		Datastore:   ptr.To(kamajiCluster.Spec.DataStore),
+	}
+
+	if deployment.Resources != nil {
+		cluster.ControlPlane.Resources = &api.ControlPlaneResources{
+			Apiserver:         api.FromResourceRequirements(deployment.Resources.APIServer),
+			ControllerManager: api.FromResourceRequirements(deployment.Resources.ControllerManager),
+			Scheduler:         api.FromResourceRequirements(deployment.Resources.Scheduler),
+			Kine:              api.FromResourceRequirements(deployment.Resources.Kine),
+		}
+	}
+
+	return cluster
 }
 
 func (m *KamajiClusterManager) ListSubscriptions(id string) ([]*api.CatalogComponent, error) {
@@ -0,0 +1,291 @@
+package clustermanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	kamaji "github.com/clastix/kamaji/api/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/utils/ptr"
+)
+
+func Test_RewriteKubeconfigServer(t *testing.T) {
+	config := clientcmdapi.NewConfig()
+	config.Clusters["kubernetes"] = &clientcmdapi.Cluster{Server: "https://test.malygos.svc:6443"}
+	config.AuthInfos["admin"] = &clientcmdapi.AuthInfo{Token: "secret"}
+	config.Contexts["admin@kubernetes"] = &clientcmdapi.Context{Cluster: "kubernetes", AuthInfo: "admin"}
+	config.CurrentContext = "admin@kubernetes"
+
+	b, err := clientcmd.Write(*config)
+	require.NoError(t, err)
+
+	kubeconfig, err := rewriteKubeconfigServer(b, "10.0.0.1:6443")
+	require.NoError(t, err)
+
+	rewritten, err := clientcmd.Load([]byte(kubeconfig))
+	require.NoError(t, err)
+	assert.Equal(t, "https://10.0.0.1:6443", rewritten.Clusters["kubernetes"].Server)
+	assert.Equal(t, "secret", rewritten.AuthInfos["admin"].Token)
+	assert.Equal(t, "admin@kubernetes", rewritten.CurrentContext)
+
+	_, err = rewriteKubeconfigServer([]byte("not a kubeconfig"), "10.0.0.1:6443")
+	assert.Error(t, err)
+}
+
+func Test_Footprint(t *testing.T) {
+	m := &KamajiClusterManager{}
+	cluster := &api.Cluster{
+		Name:    "test",
+		Region:  "test",
+		Version: "v1.29.0",
+		ControlPlane: &api.ControlPlane{
+			Replicas: ptr.To(int32(2)),
+			Resources: &api.ControlPlaneResources{
+				Apiserver: &api.ComponentResources{Requests: &api.ResourceList{Cpu: ptr.To("500m"), Memory: ptr.To("512Mi")}},
+				Scheduler: &api.ComponentResources{Requests: &api.ResourceList{Cpu: ptr.To("100m")}},
+				Kine:      &api.ComponentResources{Limits: &api.ResourceList{Memory: ptr.To("1Gi")}},
+			},
+		},
+	}
+
+	usage, err := m.Footprint(cluster)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), usage.Clusters)
+	assert.Equal(t, int64(2), usage.Replicas)
+	assert.Equal(t, "1200m", usage.CPU.String())
+	assert.Equal(t, "1Gi", usage.Memory.String())
+
+	kamajiCluster, err := buildTenantControlPlane("malygos-test", cluster)
+	require.NoError(t, err)
+	assert.Equal(t, kamaji.ServiceType("ClusterIP"), kamajiCluster.Spec.ControlPlane.Service.ServiceType)
+	assert.Equal(t, "default", kamajiCluster.Spec.DataStore)
+	assert.Equal(t, cluster.ControlPlane.Resources.Apiserver, toAPICluster(kamajiCluster).ControlPlane.Resources.Apiserver)
+
+	// an invalid control plane is rejected rather than replaced by the defaults
+	cluster.ControlPlane.Replicas = ptr.To(int32(0))
+	_, err = m.Footprint(cluster)
+	assert.True(t, errors.IsInvalidArgument(err))
+	_, err = buildTenantControlPlane("malygos-test", cluster)
+	assert.True(t, errors.IsInvalidArgument(err))
+}
+
+func Test_BuildNetworkProfile(t *testing.T) {
+	assert.Equal(t, kamaji.NetworkProfileSpec{}, buildNetworkProfile(nil))
+
+	network := &api.Network{
+		Address:     ptr.To("192.168.1.10"),
+		Port:        ptr.To(int32(443)),
+		CertSans:    ptr.To([]string{"api.example.com"}),
+		PodCidr:     ptr.To("172.16.0.0/16"),
+		ServiceCidr: ptr.To("172.17.0.0/16"),
+	}
+
+	profile := buildNetworkProfile(network)
+	assert.Equal(t, "192.168.1.10", profile.Address)
+	assert.Equal(t, int32(443), profile.Port)
+	assert.Equal(t, []string{"api.example.com"}, profile.CertSANs)
+	assert.Equal(t, []string{"172.17.0.10"}, profile.DNSServiceIPs)
+
+	network.DnsServiceIps = ptr.To([]string{"172.17.0.10"})
+	assert.Equal(t, network, toAPINetwork(&profile))
+}
+
+func Test_BuildAddons(t *testing.T) {
+	spec := buildAddons(nil)
+	assert.NotNil(t, spec.CoreDNS)
+	assert.Nil(t, spec.KubeProxy)
+	assert.Nil(t, spec.Konnectivity)
+
+	addons := &api.Addons{
+		Coredns:      &api.Addon{Enabled: false},
+		KubeProxy:    &api.Addon{Enabled: true, ImageTag: ptr.To("v1.29.0")},
+		Konnectivity: &api.KonnectivityAddon{Enabled: true},
+	}
+
+	spec = buildAddons(addons)
+	assert.Nil(t, spec.CoreDNS)
+	assert.Equal(t, "v1.29.0", spec.KubeProxy.ImageTag)
+	assert.Equal(t, api.DefaultKonnectivityServerPort, spec.Konnectivity.KonnectivityServerSpec.Port)
+
+	apiAddons := toAPIAddons(&spec)
+	assert.False(t, apiAddons.Coredns.Enabled)
+	assert.Equal(t, addons.KubeProxy, apiAddons.KubeProxy)
+	assert.Equal(t, api.DefaultKonnectivityServerPort, *apiAddons.Konnectivity.ServerPort)
+
+	patch := addonsPatch(&api.Addons{
+		Coredns:      &api.Addon{Enabled: false},
+		Konnectivity: &api.KonnectivityAddon{Enabled: true, ServerPort: ptr.To(int32(8443))},
+	})
+	assert.Contains(t, patch, "coreDNS")
+	assert.Nil(t, patch["coreDNS"])
+	assert.NotContains(t, patch, "kubeProxy")
+	b, err := json.Marshal(patch["konnectivity"])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"server":{"port":8443,"version":null},"agent":{"version":null}}`, string(b))
+}
+
+func Test_DeleteCluster(t *testing.T) {
+	tcp := &unstructured.Unstructured{}
+	tcp.SetAPIVersion(kamaji.GroupVersion.String())
+	tcp.SetKind("TenantControlPlane")
+	tcp.SetNamespace("malygos")
+	tcp.SetName("a")
+
+	secret := &unstructured.Unstructured{}
+	secret.SetAPIVersion("v1")
+	secret.SetKind("Secret")
+	secret.SetNamespace("malygos")
+	secret.SetName("a-admin-kubeconfig")
+	secret.SetLabels(map[string]string{controlPlaneLabel: "a"})
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		TenantControlPlaneResource: "TenantControlPlaneList",
+		secretsResource:            "SecretList",
+	}, tcp, secret)
+	m := NewKamajiClusterManager(logr.Discard(), client, "malygos")
+
+	assert.True(t, errors.IsNotFound(m.Delete("missing")))
+	require.NoError(t, m.Delete("a"))
+
+	// the secrets generated by kamaji are still there
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	assert.Error(t, m.WaitForDeletion(ctx, "a"))
+
+	require.NoError(t, client.Resource(secretsResource).Namespace("malygos").Delete(context.Background(), "a-admin-kubeconfig", metav1.DeleteOptions{}))
+	assert.NoError(t, m.WaitForDeletion(context.Background(), "a"))
+}
+
+func Test_ListClustersByLabel(t *testing.T) {
+	objects := []runtime.Object{}
+	for id, env := range map[string]string{"a": "dev", "b": "prod"} {
+		tcp, err := buildTenantControlPlane(id, &api.Cluster{Name: id, Region: "eu", Version: "v1.29.0",
+			Labels: &map[string]string{"env": env}, Annotations: &map[string]string{"ticket": "ABC-123"}})
+		require.NoError(t, err)
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tcp)
+		require.NoError(t, err)
+		obj := &unstructured.Unstructured{Object: u}
+		obj.SetNamespace("malygos")
+		objects = append(objects, obj)
+	}
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{TenantControlPlaneResource: "TenantControlPlaneList"}, objects...)
+	m := NewKamajiClusterManager(logr.Discard(), client, "malygos")
+
+	clusters, _, err := m.List(api.ClusterListOptions{LabelSelector: "env=dev"})
+	require.NoError(t, err)
+	require.Len(t, clusters, 1)
+	assert.Equal(t, "a", *clusters[0].Id)
+	assert.Equal(t, &map[string]string{"env": "dev"}, clusters[0].Labels)
+	assert.Equal(t, &map[string]string{"ticket": "ABC-123"}, clusters[0].Annotations)
+
+	clusters, _, err = m.List(api.ClusterListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, clusters, 2)
+}
+
+func Test_CreateClusterDuplicateName(t *testing.T) {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{TenantControlPlaneResource: "TenantControlPlaneList"})
+	m := NewKamajiClusterManager(logr.Discard(), client, "malygos")
+
+	cluster, err := m.Create(&api.Cluster{Name: "demo", Region: "eu", Version: "v1.29.0"})
+	require.NoError(t, err)
+	require.NotNil(t, cluster.Id)
+
+	_, err = m.Create(&api.Cluster{Name: "demo", Region: "eu", Version: "v1.29.0"})
+	assert.True(t, errors.IsConflict(err))
+
+	// names are only unique within a region
+	other, err := m.Create(&api.Cluster{Name: "demo", Region: "us", Version: "v1.29.0"})
+	require.NoError(t, err)
+	assert.NotEqual(t, *cluster.Id, *other.Id)
+
+	clusters, _, err := m.List(api.ClusterListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, clusters, 2)
+}
+
+func Test_CreateClusterConcurrentDuplicateName(t *testing.T) {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{TenantControlPlaneResource: "TenantControlPlaneList"})
+	// the fake client doesn't set the metadata the API server orders objects with
+	var revision atomic.Int64
+	client.PrependReactor("create", "tenantcontrolplanes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		obj := action.(k8stesting.CreateAction).GetObject().(*unstructured.Unstructured)
+		obj.SetCreationTimestamp(metav1.Now())
+		obj.SetResourceVersion(strconv.FormatInt(revision.Add(1), 10))
+		return false, nil, nil
+	})
+	m := NewKamajiClusterManager(logr.Discard(), client, "malygos")
+
+	const creations = 5
+	var wg sync.WaitGroup
+	errs := make(chan error, creations)
+	for i := 0; i < creations; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := m.Create(&api.Cluster{Name: "demo", Region: "eu", Version: "v1.29.0"})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	created := 0
+	for err := range errs {
+		if err == nil {
+			created++
+			continue
+		}
+		assert.True(t, errors.IsConflict(err), "unexpected error: %v", err)
+	}
+	assert.Equal(t, 1, created)
+
+	clusters, _, err := m.List(api.ClusterListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, clusters, 1)
+}
+
+func Test_UpdateClusterModified(t *testing.T) {
+	tcp, err := buildTenantControlPlane("a", &api.Cluster{Name: "a", Region: "eu", Version: "v1.29.0"})
+	require.NoError(t, err)
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tcp)
+	require.NoError(t, err)
+	obj := &unstructured.Unstructured{Object: u}
+	obj.SetNamespace("malygos")
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{TenantControlPlaneResource: "TenantControlPlaneList"}, obj)
+	// the cluster changes between the read and the patch
+	client.PrependReactor("patch", TenantControlPlaneResource.Resource, func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewConflict(TenantControlPlaneResource.GroupResource(), "a", fmt.Errorf("object was modified"))
+	})
+	m := NewKamajiClusterManager(logr.Discard(), client, "malygos")
+
+	_, err = m.Update("a", &api.ClusterUpdate{Addons: &api.Addons{Coredns: &api.Addon{Enabled: false}}})
+	assert.True(t, errors.IsModified(err))
+	assert.False(t, errors.IsConflict(err))
+	assert.Contains(t, err.Error(), "was modified, retry")
+}
@@ -0,0 +1,121 @@
+package clustermanager
+
+import (
+	"fmt"
+	"time"
+
+	kamaji "github.com/clastix/kamaji/api/v1alpha1"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"k8s.io/utils/ptr"
+)
+
+// toAPIClusterStatus translates the status kamaji reports on a TenantControlPlane
+func toAPIClusterStatus(kamajiCluster *kamaji.TenantControlPlane) *api.ClusterStatus {
+	kamajiStatus := &kamajiCluster.Status
+
+	phase := "Pending"
+	if kamajiStatus.Kubernetes.Version.Status != nil {
+		phase = string(*kamajiStatus.Kubernetes.Version.Status)
+	}
+
+	deployment := &kamajiStatus.Kubernetes.Deployment
+	desired := int32(0)
+	if kamajiCluster.Spec.ControlPlane.Deployment.Replicas != nil {
+		desired = *kamajiCluster.Spec.ControlPlane.Deployment.Replicas
+	}
+
+	status := &api.ClusterStatus{
+		Phase: phase,
+		Replicas: &api.ReplicasStatus{
+			Desired:   desired,
+			Ready:     deployment.ReadyReplicas,
+			Available: deployment.AvailableReplicas,
+			Updated:   deployment.UpdatedReplicas,
+		},
+		KubeconfigReady: ptr.To(kamajiStatus.KubeConfig.Admin.SecretName != ""),
+		Datastore: &api.DatastoreStatus{
+			Ready: kamajiStatus.Storage.Setup.Checksum != "",
+		},
+	}
+
+	if kamajiStatus.ControlPlaneEndpoint != "" {
+		status.Endpoint = ptr.To(kamajiStatus.ControlPlaneEndpoint)
+	}
+
+	if kamajiStatus.Kubernetes.Service.Port != 0 {
+		status.Port = ptr.To(kamajiStatus.Kubernetes.Service.Port)
+	}
+
+	if name := kamajiStatus.Storage.DataStoreName; name != "" {
+		status.Datastore.Name = ptr.To(name)
+	}
+
+	if driver := kamajiStatus.Storage.Driver; driver != "" {
+		status.Datastore.Driver = ptr.To(driver)
+	}
+
+	// kamaji reports the running version, which lags behind the spec until an upgrade completes
+	if current := kamajiStatus.Kubernetes.Version.Version; current != "" {
+		status.Version = ptr.To(current)
+		status.Upgrading = ptr.To(phase == string(kamaji.VersionUpgrading) || current != kamajiCluster.Spec.Kubernetes.Version)
+	}
+
+	endpointReady := condition("EndpointReady", status.Endpoint != nil, "EndpointAssigned", "EndpointPending", time.Time{})
+	datastoreReady := condition("DatastoreReady", status.Datastore.Ready, "DatastoreSetUp", "DatastorePending",
+		kamajiStatus.Storage.Setup.LastUpdate.Time)
+	kubeconfigReady := condition("KubeconfigReady", *status.KubeconfigReady, "KubeconfigGenerated", "KubeconfigPending",
+		kamajiStatus.KubeConfig.Admin.LastUpdate.Time)
+	available := condition("ControlPlaneAvailable", deployment.AvailableReplicas > 0, "ReplicasAvailable", "NoReplicaAvailable",
+		deployment.LastUpdate.Time)
+	available.Message = ptr.To(fmt.Sprintf("%d/%d replicas available", deployment.AvailableReplicas, desired))
+
+	// the cluster is online once kamaji reports it ready and it can actually be reached
+	status.Online = phase == string(kamaji.VersionReady) && status.Endpoint != nil && deployment.AvailableReplicas > 0
+	ready := condition("Ready", status.Online, phase, phase, time.Time{})
+	if !status.Online && phase == string(kamaji.VersionReady) {
+		ready.Reason = "Unreachable"
+	}
+
+	conditions := []api.ClusterCondition{ready, endpointReady, datastoreReady, kubeconfigReady, available}
+	for _, deploymentCondition := range deployment.Conditions {
+		c := api.ClusterCondition{
+			Type:   "Deployment" + string(deploymentCondition.Type),
+			Status: api.ClusterConditionStatus(deploymentCondition.Status),
+			Reason: deploymentCondition.Reason,
+		}
+
+		if deploymentCondition.Message != "" {
+			c.Message = ptr.To(deploymentCondition.Message)
+		}
+
+		if !deploymentCondition.LastTransitionTime.IsZero() {
+			c.LastTransitionTime = ptr.To(deploymentCondition.LastTransitionTime.Time)
+		}
+
+		conditions = append(conditions, c)
+	}
+	status.Conditions = &conditions
+
+	return status
+}
+
+func condition(conditionType string, ok bool, reason string, failureReason string, lastUpdate time.Time) api.ClusterCondition {
+	c := api.ClusterCondition{
+		Type:   conditionType,
+		Status: api.True,
+		Reason: reason,
+	}
+
+	if !ok {
+		c.Status = api.False
+		c.Reason = failureReason
+	}
+
+	// kamaji only records when it last updated the state these conditions are derived from,
+	// which is not when their status changed
+	if !lastUpdate.IsZero() {
+		c.LastUpdateTime = ptr.To(lastUpdate)
+	}
+
+	return c
+}
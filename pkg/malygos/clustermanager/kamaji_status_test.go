@@ -0,0 +1,91 @@
+package clustermanager
+
+import (
+	"testing"
+	"time"
+
+	kamaji "github.com/clastix/kamaji/api/v1alpha1"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func Test_ToAPIClusterStatus(t *testing.T) {
+	kamajiCluster := &kamaji.TenantControlPlane{}
+	kamajiCluster.Spec.Kubernetes.Version = "v1.29.0"
+	kamajiCluster.Spec.ControlPlane.Deployment.Replicas = ptr.To(int32(2))
+
+	status := toAPIClusterStatus(kamajiCluster)
+	assert.Equal(t, "Pending", status.Phase)
+	assert.False(t, status.Online)
+	assert.False(t, *status.KubeconfigReady)
+	assert.False(t, status.Datastore.Ready)
+	assert.Nil(t, status.Endpoint)
+	assert.Equal(t, int32(2), status.Replicas.Desired)
+	for _, condition := range *status.Conditions {
+		assert.Equal(t, api.False, condition.Status, condition.Type)
+	}
+
+	transition := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	kamajiCluster.Status = kamaji.TenantControlPlaneStatus{
+		ControlPlaneEndpoint: "10.0.0.1:6443",
+		KubeConfig: kamaji.KubeconfigsStatus{
+			Admin: kamaji.KubeconfigStatus{SecretName: "test-admin-kubeconfig", LastUpdate: metav1.NewTime(transition)},
+		},
+		Storage: kamaji.StorageStatus{
+			Driver:        "etcd",
+			DataStoreName: "default",
+			Setup:         kamaji.DataStoreSetupStatus{Checksum: "abc", LastUpdate: metav1.NewTime(transition)},
+		},
+		Kubernetes: kamaji.KubernetesStatus{
+			Version: kamaji.KubernetesVersion{Version: "v1.28.4", Status: ptr.To(kamaji.VersionReady)},
+			Service: kamaji.KubernetesServiceStatus{Port: 6443},
+			Deployment: kamaji.KubernetesDeploymentStatus{
+				DeploymentStatus: appsv1.DeploymentStatus{
+					ReadyReplicas:     2,
+					AvailableReplicas: 1,
+					UpdatedReplicas:   1,
+					Conditions: []appsv1.DeploymentCondition{{
+						Type:               appsv1.DeploymentProgressing,
+						Status:             v1.ConditionTrue,
+						Reason:             "ReplicaSetUpdated",
+						LastTransitionTime: metav1.NewTime(transition),
+					}},
+				},
+			},
+		},
+	}
+
+	status = toAPIClusterStatus(kamajiCluster)
+	assert.Equal(t, "Ready", status.Phase)
+	assert.True(t, status.Online)
+	assert.Equal(t, "10.0.0.1:6443", *status.Endpoint)
+	assert.Equal(t, int32(6443), *status.Port)
+	assert.True(t, *status.KubeconfigReady)
+	assert.Equal(t, "etcd", *status.Datastore.Driver)
+	assert.Equal(t, "v1.28.4", *status.Version)
+	assert.True(t, *status.Upgrading)
+	assert.Equal(t, api.ReplicasStatus{Desired: 2, Ready: 2, Available: 1, Updated: 1}, *status.Replicas)
+
+	conditions := map[string]api.ClusterCondition{}
+	for _, condition := range *status.Conditions {
+		conditions[condition.Type] = condition
+	}
+
+	for _, conditionType := range []string{"Ready", "EndpointReady", "DatastoreReady", "KubeconfigReady", "ControlPlaneAvailable"} {
+		assert.Equal(t, api.True, conditions[conditionType].Status, conditionType)
+	}
+	assert.Equal(t, transition, *conditions["DatastoreReady"].LastUpdateTime)
+	assert.Nil(t, conditions["DatastoreReady"].LastTransitionTime)
+	assert.Equal(t, "1/2 replicas available", *conditions["ControlPlaneAvailable"].Message)
+	assert.Equal(t, "ReplicaSetUpdated", conditions["DeploymentProgressing"].Reason)
+	assert.Equal(t, transition, *conditions["DeploymentProgressing"].LastTransitionTime)
+
+	kamajiCluster.Status.Kubernetes.Deployment.AvailableReplicas = 0
+	status = toAPIClusterStatus(kamajiCluster)
+	assert.False(t, status.Online)
+	assert.Equal(t, "Unreachable", (*status.Conditions)[0].Reason)
+}
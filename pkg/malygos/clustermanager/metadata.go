@@ -0,0 +1,132 @@
+package clustermanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strconv"
+
+	"github.com/nrz-incubator/malygos/pkg/api"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// clusterMetadata returns the labels and annotations a cluster is stored with, whatever the provider
+func clusterMetadata(cluster *api.Cluster) (map[string]string, map[string]string) {
+	labels := map[string]string{
+		regionalClusterLabel: cluster.Region,
+	}
+	annotations := map[string]string{
+		nameAnnotation: cluster.Name,
+	}
+
+	if cluster.Owner != nil {
+		annotations[ownerAnnotation] = *cluster.Owner
+	}
+
+	if cluster.Team != nil && *cluster.Team != "" {
+		labels[teamLabel] = *cluster.Team
+	}
+
+	if cluster.Labels != nil {
+		maps.Copy(labels, api.PrefixUserLabels(*cluster.Labels))
+	}
+
+	if cluster.Annotations != nil {
+		maps.Copy(annotations, api.PrefixUserLabels(*cluster.Annotations))
+	}
+
+	return labels, annotations
+}
+
+// setClusterMetadata fills the fields of cluster stored in the labels and annotations of its object
+func setClusterMetadata(cluster *api.Cluster, labels map[string]string, annotations map[string]string) {
+	cluster.Name = annotations[nameAnnotation]
+
+	if region, ok := labels[regionalClusterLabel]; ok {
+		cluster.Region = region
+	} else {
+		cluster.Region = "unknown"
+	}
+
+	if owner, ok := annotations[ownerAnnotation]; ok {
+		cluster.Owner = ptr.To(owner)
+	}
+
+	if team, ok := labels[teamLabel]; ok {
+		cluster.Team = ptr.To(team)
+	}
+
+	cluster.Labels = api.UserLabels(labels)
+	cluster.Annotations = api.UserLabels(annotations)
+}
+
+// quotaTeam is the team the clusters are counted against by quotas, clusters without a team count
+// against their owner
+func quotaTeam(labels map[string]string, annotations map[string]string) string {
+	if team := labels[teamLabel]; team != "" {
+		return team
+	}
+
+	return annotations[ownerAnnotation]
+}
+
+// ownershipPatch is the merge patch replacing the owner and team of a cluster object
+func ownershipPatch(owner string, team string) ([]byte, error) {
+	// a null label removes the team
+	var teamValue interface{}
+	if team != "" {
+		teamValue = team
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				ownerAnnotation: owner,
+			},
+			"labels": map[string]interface{}{
+				teamLabel: teamValue,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ownership patch: %v", err)
+	}
+
+	return patch, nil
+}
+
+// oldestNamed returns the id of the oldest of objects stored for a cluster named name, empty when
+// there is none. Names are annotations as they don't have to be valid label values, so concurrent
+// creations with the same name are only detected once created: all of them keep the oldest object.
+func oldestNamed(objects []metav1.Object, name string) string {
+	var oldest metav1.Object
+	for _, obj := range objects {
+		if obj.GetAnnotations()[nameAnnotation] == name && (oldest == nil || olderObject(obj, oldest)) {
+			oldest = obj
+		}
+	}
+
+	if oldest == nil {
+		return ""
+	}
+
+	return oldest.GetName()
+}
+
+// olderObject orders objects by creation, objects created within the same second are ordered by
+// resource version, which the API server increases with every write
+func olderObject(a metav1.Object, b metav1.Object) bool {
+	createdA, createdB := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+	if !createdA.Equal(&createdB) {
+		return createdA.Before(&createdB)
+	}
+
+	versionA, errA := strconv.ParseUint(a.GetResourceVersion(), 10, 64)
+	versionB, errB := strconv.ParseUint(b.GetResourceVersion(), 10, 64)
+	if errA == nil && errB == nil && versionA != versionB {
+		return versionA < versionB
+	}
+
+	return a.GetName() < b.GetName()
+}
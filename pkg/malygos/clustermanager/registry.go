@@ -0,0 +1,128 @@
+package clustermanager
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// Provider is a backend provisioning the clusters of the registrars declaring its name.
+type Provider struct {
+	// Name is the value the registrars declare the provider with, the provider is registered under it
+	Name string
+	// New builds the cluster manager of a region from a client of its management cluster, the
+	// registrar may configure the provider through its annotations
+	New func(logger logr.Logger, registrar *api.ClusterRegistrar, client dynamic.Interface, namespace string) (api.ClusterManager, error)
+	// Resource holds a cluster per object, it is watched to stream the cluster changes
+	Resource schema.GroupVersionResource
+	// Convert translates the objects of Resource to clusters from the object alone, as watched
+	// clusters are converted on every event
+	Convert func(obj *unstructured.Unstructured) (*api.Cluster, error)
+	// Capabilities are checked by the API before submitting requests to the provider
+	Capabilities api.ClusterCapabilities
+}
+
+// Registry maps the provider names declared by the registrars to their implementation, providers
+// are registered at startup.
+type Registry struct {
+	providers map[string]*Provider
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		providers: map[string]*Provider{},
+	}
+}
+
+// NewDefaultRegistry returns a registry with the built-in providers.
+func NewDefaultRegistry() *Registry {
+	registry := NewRegistry()
+	_ = registry.Register(KamajiProvider())
+	_ = registry.Register(CAPIProvider())
+	return registry
+}
+
+// Register adds a provider under its name.
+func (r *Registry) Register(provider *Provider) error {
+	name := provider.Name
+	if name == "" {
+		return fmt.Errorf("cluster provider must have a name")
+	}
+
+	if _, ok := r.providers[name]; ok {
+		return errors.NewConflictError("cluster provider", name)
+	}
+
+	r.providers[name] = provider
+	return nil
+}
+
+// Get returns the provider registered as name.
+func (r *Registry) Get(name string) (*Provider, error) {
+	provider, ok := r.providers[name]
+	if !ok {
+		return nil, errors.NewNotFoundError("cluster provider", name)
+	}
+
+	return provider, nil
+}
+
+// Names returns the sorted names of the registered providers.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// KamajiProvider provisions clusters as Kamaji tenant control planes.
+func KamajiProvider() *Provider {
+	return &Provider{
+		Name: api.DefaultClusterProvider,
+		New: func(logger logr.Logger, _ *api.ClusterRegistrar, client dynamic.Interface, namespace string) (api.ClusterManager, error) {
+			return NewKamajiClusterManager(logger, client, namespace), nil
+		},
+		Resource: TenantControlPlaneResource,
+		Convert:  UnstructuredToAPICluster,
+		Capabilities: api.ClusterCapabilities{
+			Provider:                "Kamaji",
+			ControlPlaneReplicas:    true,
+			ControlPlaneResources:   true,
+			ControlPlaneServiceType: true,
+			Datastore:               true,
+			Network:                 true,
+			Addons:                  true,
+			Upgrades:                true,
+		},
+	}
+}
+
+// CAPIProvider provisions clusters with Cluster API, after the topology of their region.
+func CAPIProvider() *Provider {
+	return &Provider{
+		Name: CAPIProviderName,
+		New: func(logger logr.Logger, registrar *api.ClusterRegistrar, client dynamic.Interface, namespace string) (api.ClusterManager, error) {
+			topology, err := GetCAPITopology(registrar)
+			if err != nil {
+				return nil, err
+			}
+			return NewCAPIClusterManager(logger, client, namespace, topology), nil
+		},
+		Resource: CAPIClusterResource,
+		Convert:  UnstructuredCAPIToAPICluster,
+		Capabilities: api.ClusterCapabilities{
+			Provider:             "Cluster API",
+			ControlPlaneReplicas: true,
+			Upgrades:             true,
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package clustermanager
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func Test_Registry(t *testing.T) {
+	registry := NewDefaultRegistry()
+	assert.Equal(t, []string{CAPIProviderName, api.DefaultClusterProvider}, registry.Names())
+
+	provider, err := registry.Get(api.DefaultClusterProvider)
+	require.NoError(t, err)
+	assert.Equal(t, TenantControlPlaneResource, provider.Resource)
+	assert.True(t, provider.Capabilities.Upgrades)
+	clusterManager, err := provider.New(logr.Discard(), &api.ClusterRegistrar{}, dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()), "malygos")
+	require.NoError(t, err)
+	assert.IsType(t, &KamajiClusterManager{}, clusterManager)
+
+	_, err = registry.Get("openstack")
+	assert.True(t, errors.IsNotFound(err))
+
+	assert.True(t, errors.IsConflict(registry.Register(KamajiProvider())))
+	assert.Error(t, registry.Register(&Provider{Capabilities: api.ClusterCapabilities{Provider: "openstack"}}),
+		"providers are registered under their name")
+
+	require.NoError(t, registry.Register(&Provider{Name: "openstack"}))
+	assert.Equal(t, []string{"capi", "kamaji", "openstack"}, registry.Names())
+}
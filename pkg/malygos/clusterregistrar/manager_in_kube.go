@@ -50,8 +50,9 @@ func (m *InKubeClusterManager) Create(cluster *api.ClusterRegistrar) (*api.Clust
 			Kind:       "Registrar",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      cluster.Name,
-			Namespace: m.cfgNamespace,
+			Name:        cluster.Name,
+			Namespace:   m.cfgNamespace,
+			Annotations: cluster.Annotations,
 		},
 		Spec: malygosv1.RegistrarSpec{
 			Region:     cluster.Region,
@@ -121,10 +122,11 @@ func (m *InKubeClusterManager) List() ([]*api.ClusterRegistrar, error) {
 
 	for _, registar := range registrars.Items {
 		clusters = append(clusters, &api.ClusterRegistrar{
-			Id:         registar.Name,
-			Name:       registar.Name,
-			Region:     registar.Spec.Region,
-			Kubeconfig: registar.Spec.Kubeconfig,
+			Id:          registar.Name,
+			Name:        registar.Name,
+			Region:      registar.Spec.Region,
+			Kubeconfig:  registar.Spec.Kubeconfig,
+			Annotations: registar.Annotations,
 		})
 	}
 	return clusters, nil
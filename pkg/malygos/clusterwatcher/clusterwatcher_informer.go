@@ -0,0 +1,499 @@
+package clusterwatcher
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// DefaultHistorySize is the number of events kept per region to resume watches
+	DefaultHistorySize = 1000
+	subscriberBuffer   = 256
+	defaultSyncTimeout = 10 * time.Second
+	// informers of regions nobody watches are stopped after this long, their history is lost
+	defaultIdleTimeout = 5 * time.Minute
+)
+
+// Converter translates a cluster object of a registrar to the API representation. It runs on every
+// event, so it must not read from the management cluster.
+type Converter func(obj *unstructured.Unstructured) (*api.Cluster, error)
+
+// ResourceResolver returns the resource holding the clusters of a registrar and its converter, which
+// depend on the provider of the region.
+type ResourceResolver func(registrar *api.ClusterRegistrar) (schema.GroupVersionResource, Converter, error)
+
+// InformerClusterWatcher runs an informer on the cluster objects of each registrar, started on the
+// first watch of its region and stopped once it isn't watched anymore, and keeps the latest events
+// of each region so that clients can resume.
+type InformerClusterWatcher struct {
+	ctx         context.Context
+	logger      logr.Logger
+	registrars  api.ClusterRegistrarManager
+	namespace   string
+	resolve     ResourceResolver
+	historySize int
+	idleTimeout time.Duration
+	syncTimeout time.Duration
+	newClient   func(registrar *api.ClusterRegistrar) (dynamic.Interface, error)
+
+	lock    sync.Mutex
+	regions map[string]*regionWatcher
+}
+
+func NewInformerClusterWatcher(ctx context.Context, logger logr.Logger, registrars api.ClusterRegistrarManager, namespace string,
+	resolve ResourceResolver) *InformerClusterWatcher {
+	return &InformerClusterWatcher{
+		ctx:         ctx,
+		logger:      logger,
+		registrars:  registrars,
+		namespace:   namespace,
+		resolve:     resolve,
+		historySize: DefaultHistorySize,
+		idleTimeout: defaultIdleTimeout,
+		syncTimeout: defaultSyncTimeout,
+		newClient: func(registrar *api.ClusterRegistrar) (dynamic.Interface, error) {
+			return registrar.CreateDynamicClient()
+		},
+		regions: map[string]*regionWatcher{},
+	}
+}
+
+func (w *InformerClusterWatcher) Watch(ctx context.Context, regions []string, cursor map[string]string) (<-chan api.ClusterEvent, error) {
+	watchers, failed, err := w.sync()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, region := range regions {
+		if _, ok := watchers[region]; !ok && failed[region] == nil {
+			w.release(watchers)
+			return nil, errors.NewNotFoundError("region", region)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	out := make(chan api.ClusterEvent, subscriberBuffer)
+	wg := sync.WaitGroup{}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for region, err := range failed {
+			if len(regions) > 0 && !slices.Contains(regions, region) {
+				continue
+			}
+
+			send(ctx, out, api.ClusterEvent{
+				Type:    api.ClusterWarning,
+				Region:  region,
+				Message: fmt.Sprintf("clusters of region %s can't be watched: %v", region, err),
+			})
+		}
+	}()
+
+	for region, watcher := range watchers {
+		if len(regions) > 0 && !slices.Contains(regions, region) {
+			w.releaseOne(region, watcher)
+			continue
+		}
+
+		wg.Add(1)
+		go func(region string, watcher *regionWatcher) {
+			defer wg.Done()
+			defer w.releaseOne(region, watcher)
+			// a closed subscription ends the whole watch, the client resumes from its cursor
+			defer cancel()
+
+			if !w.waitForSync(ctx, watcher, out) {
+				return
+			}
+
+			events := watcher.subscribe(cursor[region])
+			defer watcher.unsubscribe(events)
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case event, ok := <-events:
+					if !ok {
+						return
+					}
+
+					if !send(ctx, out, event) {
+						return
+					}
+				}
+			}
+		}(region, watcher)
+	}
+
+	go func() {
+		<-ctx.Done()
+		wg.Wait()
+		cancel()
+		close(out)
+	}()
+
+	return out, nil
+}
+
+// waitForSync waits for the initial list of the clusters of a region. An unreachable registrar must
+// not hold the other regions back, so the consumer is warned when it takes too long and the region
+// joins the watch once listed.
+func (w *InformerClusterWatcher) waitForSync(ctx context.Context, watcher *regionWatcher, out chan<- api.ClusterEvent) bool {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-watcher.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	syncCtx, syncCancel := context.WithTimeout(ctx, w.syncTimeout)
+	synced := cache.WaitForCacheSync(syncCtx.Done(), watcher.informer.HasSynced)
+	syncCancel()
+	if synced {
+		return true
+	}
+
+	if ctx.Err() != nil {
+		return false
+	}
+
+	watcher.logger.Info("clusters of region not synced yet")
+	send(ctx, out, api.ClusterEvent{
+		Type:    api.ClusterWarning,
+		Region:  watcher.region,
+		Message: fmt.Sprintf("clusters of region %s are not synced yet, they will be sent once they are", watcher.region),
+	})
+
+	return cache.WaitForCacheSync(ctx.Done(), watcher.informer.HasSynced)
+}
+
+func send(ctx context.Context, out chan<- api.ClusterEvent, event api.ClusterEvent) bool {
+	select {
+	case out <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// sync starts the informers of new registrars and stops those of removed or changed ones. The
+// returned watchers are held until released, the regions whose informer can't be started are
+// returned with the error.
+func (w *InformerClusterWatcher) sync() (map[string]*regionWatcher, map[string]error, error) {
+	registrars, err := w.registrars.List()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list registrars: %v", err)
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	current := map[string]*api.ClusterRegistrar{}
+	for _, registrar := range registrars {
+		current[registrar.Region] = registrar
+	}
+
+	for region, watcher := range w.regions {
+		if registrar, ok := current[region]; !ok || registrar.Kubeconfig != watcher.kubeconfig ||
+			registrar.GetProvider() != watcher.provider {
+			watcher.stop()
+			delete(w.regions, region)
+		}
+	}
+
+	watchers := map[string]*regionWatcher{}
+	failed := map[string]error{}
+	for region, registrar := range current {
+		watcher, ok := w.regions[region]
+		if !ok {
+			watcher, err = w.start(registrar)
+			if err != nil {
+				w.logger.Error(err, "failed to watch clusters", "region", region)
+				failed[region] = err
+				continue
+			}
+			w.regions[region] = watcher
+		}
+
+		watcher.users++
+		watchers[region] = watcher
+	}
+
+	return watchers, failed, nil
+}
+
+func (w *InformerClusterWatcher) release(watchers map[string]*regionWatcher) {
+	for region, watcher := range watchers {
+		w.releaseOne(region, watcher)
+	}
+}
+
+// releaseOne stops the informer of a region once no watch used it for the idle timeout
+func (w *InformerClusterWatcher) releaseOne(region string, watcher *regionWatcher) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	watcher.users--
+	if watcher.users > 0 {
+		return
+	}
+
+	time.AfterFunc(w.idleTimeout, func() {
+		w.lock.Lock()
+		defer w.lock.Unlock()
+
+		if w.regions[region] == watcher && watcher.users == 0 {
+			watcher.logger.Info("no more watches, stopping informer")
+			watcher.stop()
+			delete(w.regions, region)
+		}
+	})
+}
+
+func (w *InformerClusterWatcher) start(registrar *api.ClusterRegistrar) (*regionWatcher, error) {
+	resource, convert, err := w.resolve(registrar)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve cluster resource: %v", err)
+	}
+
+	client, err := w.newClient(registrar)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create k8s client for management cluster: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(w.ctx)
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(client, 0, w.namespace, nil)
+	watcher := &regionWatcher{
+		logger:      w.logger.WithValues("region", registrar.Region),
+		region:      registrar.Region,
+		kubeconfig:  registrar.Kubeconfig,
+		provider:    registrar.GetProvider(),
+		convert:     convert,
+		historySize: w.historySize,
+		informer:    factory.ForResource(resource).Informer(),
+		cancel:      cancel,
+		done:        ctx.Done(),
+		subscribers: map[chan api.ClusterEvent]struct{}{},
+	}
+
+	_, err = watcher.informer.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			// the initial list is served from the store to new subscribers
+			if !isInInitialList {
+				watcher.dispatch(api.ClusterAdded, obj)
+			}
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			watcher.dispatch(api.ClusterModified, obj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			watcher.dispatch(api.ClusterDeleted, obj)
+		},
+	})
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to register event handler: %v", err)
+	}
+
+	factory.Start(ctx.Done())
+	go func() {
+		if cache.WaitForCacheSync(ctx.Done(), watcher.informer.HasSynced) {
+			watcher.setBase(watcher.informer.LastSyncResourceVersion())
+		}
+	}()
+
+	return watcher, nil
+}
+
+type regionWatcher struct {
+	logger      logr.Logger
+	region      string
+	kubeconfig  string
+	provider    string
+	convert     Converter
+	historySize int
+	informer    cache.SharedIndexInformer
+	cancel      context.CancelFunc
+	done        <-chan struct{}
+	// users counts the watches holding the watcher, guarded by the InformerClusterWatcher lock
+	users int
+
+	lock sync.Mutex
+	// base is the resource version preceding the oldest event of history
+	base        string
+	history     []api.ClusterEvent
+	subscribers map[chan api.ClusterEvent]struct{}
+	stopped     bool
+}
+
+// setBase records the resource version of the initial list, unless events were already received
+func (r *regionWatcher) setBase(resourceVersion string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.base == "" && len(r.history) == 0 {
+		r.base = resourceVersion
+	}
+}
+
+func (r *regionWatcher) dispatch(eventType api.ClusterEventType, obj interface{}) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	cluster, err := r.convert(u)
+	if err != nil {
+		r.logger.Error(err, "failed to convert cluster", "name", u.GetName())
+		return
+	}
+
+	event := api.ClusterEvent{
+		Type:            eventType,
+		Region:          r.region,
+		ResourceVersion: u.GetResourceVersion(),
+		Cluster:         cluster,
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.history = append(r.history, event)
+	if len(r.history) > r.historySize {
+		r.base = r.history[0].ResourceVersion
+		r.history = slices.Delete(r.history, 0, 1)
+	}
+
+	for subscriber := range r.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+			// the consumer fell behind, it will resume from its cursor
+			delete(r.subscribers, subscriber)
+			close(subscriber)
+		}
+	}
+}
+
+// subscribe returns the events following resourceVersion, or every cluster of the region as ADDED
+// followed by the next events when resourceVersion is unset. An unknown resourceVersion, or one
+// which is no longer in the history, is followed by a RESET before the clusters.
+func (r *regionWatcher) subscribe(resourceVersion string) chan api.ClusterEvent {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	replay := r.replay(resourceVersion)
+	events := make(chan api.ClusterEvent, len(replay)+subscriberBuffer)
+	for _, event := range replay {
+		events <- event
+	}
+
+	if r.stopped {
+		close(events)
+		return events
+	}
+
+	r.subscribers[events] = struct{}{}
+	return events
+}
+
+func (r *regionWatcher) replay(resourceVersion string) []api.ClusterEvent {
+	if resourceVersion != "" {
+		if resourceVersion == r.base {
+			return slices.Clone(r.history)
+		}
+
+		// deletions carry the last resource version of the object, so the first match is used to
+		// never skip one, at the cost of sending it twice
+		for i, event := range r.history {
+			if event.ResourceVersion == resourceVersion {
+				return slices.Clone(r.history[i+1:])
+			}
+		}
+	}
+
+	// the snapshot events are positioned at the latest event so that resuming from them replays
+	// nothing that is already reflected in the snapshot
+	position := r.base
+	if position == "" {
+		position = r.informer.LastSyncResourceVersion()
+	}
+	if len(r.history) > 0 {
+		position = r.history[len(r.history)-1].ResourceVersion
+	}
+
+	snapshot := []api.ClusterEvent{}
+	if resourceVersion != "" {
+		snapshot = append(snapshot, api.ClusterEvent{
+			Type:            api.ClusterReset,
+			Region:          r.region,
+			ResourceVersion: position,
+		})
+	}
+
+	for _, obj := range r.informer.GetStore().List() {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+
+		cluster, err := r.convert(u)
+		if err != nil {
+			r.logger.Error(err, "failed to convert cluster", "name", u.GetName())
+			continue
+		}
+
+		snapshot = append(snapshot, api.ClusterEvent{
+			Type:            api.ClusterAdded,
+			Region:          r.region,
+			ResourceVersion: position,
+			Cluster:         cluster,
+		})
+	}
+
+	return snapshot
+}
+
+func (r *regionWatcher) unsubscribe(events chan api.ClusterEvent) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.subscribers[events]; ok {
+		delete(r.subscribers, events)
+		close(events)
+	}
+}
+
+func (r *regionWatcher) stop() {
+	r.cancel()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.stopped = true
+	for subscriber := range r.subscribers {
+		delete(r.subscribers, subscriber)
+		close(subscriber)
+	}
+}
@@ -0,0 +1,224 @@
+package clusterwatcher
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+)
+
+var testResource = schema.GroupVersionResource{Group: "kamaji.clastix.io", Version: "v1alpha1", Resource: "tenantcontrolplanes"}
+
+type staticRegistrars struct {
+	registrars []*api.ClusterRegistrar
+}
+
+func (s *staticRegistrars) Create(cluster *api.ClusterRegistrar) (*api.ClusterRegistrar, error) {
+	return cluster, nil
+}
+
+func (s *staticRegistrars) Delete(region string) error {
+	return nil
+}
+
+func (s *staticRegistrars) List() ([]*api.ClusterRegistrar, error) {
+	return s.registrars, nil
+}
+
+func (s *staticRegistrars) Get(region string) (*api.ClusterRegistrar, error) {
+	return nil, nil
+}
+
+func testCluster(name string, resourceVersion string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("kamaji.clastix.io/v1alpha1")
+	u.SetKind("TenantControlPlane")
+	u.SetNamespace("malygos")
+	u.SetName(name)
+	u.SetResourceVersion(resourceVersion)
+	return u
+}
+
+func convert(obj *unstructured.Unstructured) (*api.Cluster, error) {
+	return &api.Cluster{Id: ptr.To(obj.GetName()), Region: "eu"}, nil
+}
+
+func next(t *testing.T, events <-chan api.ClusterEvent) api.ClusterEvent {
+	t.Helper()
+	select {
+	case event, ok := <-events:
+		require.True(t, ok, "watch closed")
+		return event
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no event received")
+	}
+	return api.ClusterEvent{}
+}
+
+func Test_InformerClusterWatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{testResource: "TenantControlPlaneList"}, testCluster("a", "1"))
+	resource := client.Resource(testResource).Namespace("malygos")
+
+	registrars := &staticRegistrars{registrars: []*api.ClusterRegistrar{{Name: "eu", Region: "eu"}}}
+	watcher := NewInformerClusterWatcher(ctx, logr.Discard(), registrars, "malygos",
+		func(_ *api.ClusterRegistrar) (schema.GroupVersionResource, Converter, error) {
+			return testResource, convert, nil
+		})
+	watcher.newClient = func(_ *api.ClusterRegistrar) (dynamic.Interface, error) {
+		return client, nil
+	}
+
+	watchCtx, watchCancel := context.WithCancel(ctx)
+	events, err := watcher.Watch(watchCtx, nil, nil)
+	require.NoError(t, err)
+
+	event := next(t, events)
+	assert.Equal(t, api.ClusterAdded, event.Type)
+	assert.Equal(t, "a", *event.Cluster.Id)
+	assert.Equal(t, "eu", event.Region)
+
+	_, err = resource.Create(ctx, testCluster("b", "2"), metav1.CreateOptions{})
+	require.NoError(t, err)
+	event = next(t, events)
+	assert.Equal(t, api.ClusterAdded, event.Type)
+	assert.Equal(t, "b", *event.Cluster.Id)
+
+	_, err = resource.Update(ctx, testCluster("a", "3"), metav1.UpdateOptions{})
+	require.NoError(t, err)
+	event = next(t, events)
+	assert.Equal(t, api.ClusterModified, event.Type)
+	assert.Equal(t, "3", event.ResourceVersion)
+
+	require.NoError(t, resource.Delete(ctx, "b", metav1.DeleteOptions{}))
+	event = next(t, events)
+	assert.Equal(t, api.ClusterDeleted, event.Type)
+	assert.Equal(t, "b", *event.Cluster.Id)
+
+	watchCancel()
+	for range events {
+	}
+
+	// resuming replays the events following the cursor
+	events, err = watcher.Watch(ctx, []string{"eu"}, map[string]string{"eu": "3"})
+	require.NoError(t, err)
+	event = next(t, events)
+	assert.Equal(t, api.ClusterDeleted, event.Type)
+	assert.Equal(t, "b", *event.Cluster.Id)
+
+	// an unknown cursor resets the region before starting over from the current clusters
+	events, err = watcher.Watch(ctx, nil, map[string]string{"eu": "42"})
+	require.NoError(t, err)
+	event = next(t, events)
+	assert.Equal(t, api.ClusterReset, event.Type)
+	assert.Equal(t, "eu", event.Region)
+	assert.Nil(t, event.Cluster)
+	event = next(t, events)
+	assert.Equal(t, api.ClusterAdded, event.Type)
+	assert.Equal(t, "a", *event.Cluster.Id)
+	assert.Equal(t, "2", event.ResourceVersion)
+
+	// unknown regions are rejected
+	_, err = watcher.Watch(ctx, []string{"us"}, nil)
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func Test_InformerClusterWatcherRegions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cluster := testCluster("a", "1")
+	cluster.SetNamespace("eu")
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{testResource: "TenantControlPlaneList"}, cluster)
+	// the clusters of us can't be listed
+	client.PrependReactor("list", testResource.Resource, func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "us" {
+			return true, nil, fmt.Errorf("unreachable")
+		}
+		return false, nil, nil
+	})
+
+	registrars := &staticRegistrars{registrars: []*api.ClusterRegistrar{
+		{Name: "eu", Region: "eu", Kubeconfig: "eu"},
+		{Name: "us", Region: "us", Kubeconfig: "us"},
+		{Name: "ap", Region: "ap", Kubeconfig: "ap"},
+	}}
+	watcher := NewInformerClusterWatcher(ctx, logr.Discard(), registrars, "malygos",
+		func(registrar *api.ClusterRegistrar) (schema.GroupVersionResource, Converter, error) {
+			if registrar.Region == "ap" {
+				return schema.GroupVersionResource{}, nil, fmt.Errorf("unknown provider")
+			}
+			return testResource, convert, nil
+		})
+	watcher.syncTimeout = 300 * time.Millisecond
+	watcher.idleTimeout = 50 * time.Millisecond
+	watcher.newClient = func(registrar *api.ClusterRegistrar) (dynamic.Interface, error) {
+		return &namespacedClient{Interface: client, namespace: registrar.Region}, nil
+	}
+
+	watchCtx, watchCancel := context.WithCancel(ctx)
+	events, err := watcher.Watch(watchCtx, nil, nil)
+	require.NoError(t, err)
+
+	received := map[string]api.ClusterEvent{}
+	for len(received) < 3 {
+		event := next(t, events)
+		if event.Type == api.ClusterAdded || event.Region != "eu" {
+			received[event.Region] = event
+		}
+	}
+
+	assert.Equal(t, api.ClusterAdded, received["eu"].Type)
+	assert.Equal(t, api.ClusterWarning, received["us"].Type)
+	assert.Contains(t, received["us"].Message, "not synced yet")
+	assert.Equal(t, api.ClusterWarning, received["ap"].Type)
+	assert.Contains(t, received["ap"].Message, "can't be watched")
+
+	// informers are stopped once nobody watches their region
+	watchCancel()
+	for range events {
+	}
+
+	assert.Eventually(t, func() bool {
+		watcher.lock.Lock()
+		defer watcher.lock.Unlock()
+		return len(watcher.regions) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+// namespacedClient lists the clusters of every region from its own namespace of a shared fake client
+type namespacedClient struct {
+	dynamic.Interface
+	namespace string
+}
+
+func (c *namespacedClient) Resource(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return &namespacedResource{NamespaceableResourceInterface: c.Interface.Resource(resource), namespace: c.namespace}
+}
+
+type namespacedResource struct {
+	dynamic.NamespaceableResourceInterface
+	namespace string
+}
+
+func (r *namespacedResource) Namespace(string) dynamic.ResourceInterface {
+	return r.NamespaceableResourceInterface.Namespace(r.namespace)
+}
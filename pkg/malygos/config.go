@@ -3,6 +3,8 @@ package malygos
 import (
 	"fmt"
 	"os"
+
+	"github.com/nrz-incubator/malygos/pkg/malygos/auth"
 )
 
 func (m *Malygos) readConfiguration() error {
@@ -16,8 +18,51 @@ func (m *Malygos) readConfiguration() error {
 		return fmt.Errorf("MANAGEMENT_NAMESPACE variable not set")
 	}
 
+	m.auth.jwt = auth.JWTConfig{
+		Issuer:        os.Getenv("JWT_ISSUER"),
+		Audience:      os.Getenv("JWT_AUDIENCE"),
+		JWKS:          os.Getenv("JWT_JWKS"),
+		UsernameClaim: os.Getenv("JWT_USERNAME_CLAIM"),
+		GroupsClaim:   os.Getenv("JWT_GROUPS_CLAIM"),
+	}
+
+	m.auth.htpasswdFile = os.Getenv("BASIC_AUTH_HTPASSWD_FILE")
+	m.auth.htpasswdSecret = os.Getenv("BASIC_AUTH_SECRET")
+	m.auth.htpasswdSecretKey = os.Getenv("BASIC_AUTH_SECRET_KEY")
+	if m.auth.htpasswdFile != "" && m.auth.htpasswdSecret != "" {
+		return fmt.Errorf("BASIC_AUTH_HTPASSWD_FILE and BASIC_AUTH_SECRET are mutually exclusive")
+	}
+
+	m.tls.certFile = os.Getenv("TLS_CERT_FILE")
+	m.tls.keyFile = os.Getenv("TLS_KEY_FILE")
+	m.tls.clientCAFile = os.Getenv("TLS_CLIENT_CA_FILE")
+	if (m.tls.certFile == "") != (m.tls.keyFile == "") {
+		return fmt.Errorf("TLS_CERT_FILE and TLS_KEY_FILE must be set together")
+	}
+
+	if m.tls.clientCAFile != "" && m.tls.certFile == "" {
+		return fmt.Errorf("TLS_CLIENT_CA_FILE requires TLS_CERT_FILE and TLS_KEY_FILE")
+	}
+
+	m.rbac.Backend = os.Getenv("RBAC_BACKEND")
+	m.rbac.PolicyFile = os.Getenv("RBAC_POLICY_FILE")
+
+	m.audit.File = os.Getenv("AUDIT_FILE")
+	m.audit.Stdout = os.Getenv("AUDIT_STDOUT") == "true"
+	m.audit.WebhookURL = os.Getenv("AUDIT_WEBHOOK_URL")
+
 	m.logger.WithValues("kubeconfig", m.kubeconfig,
 		"managementNamespace", m.managementNamespace,
+		"jwtIssuer", m.auth.jwt.Issuer,
+		"jwtJWKS", m.auth.jwt.JWKS,
+		"htpasswdFile", m.auth.htpasswdFile,
+		"htpasswdSecret", m.auth.htpasswdSecret,
+		"tlsCertFile", m.tls.certFile,
+		"tlsClientCAFile", m.tls.clientCAFile,
+		"rbacBackend", m.rbac.Backend,
+		"auditFile", m.audit.File,
+		"auditStdout", m.audit.Stdout,
+		"auditWebhook", m.audit.WebhookURL != "",
 	).Info("configuration set")
 
 	return nil
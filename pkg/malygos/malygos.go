@@ -2,8 +2,11 @@ package malygos
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/zapr"
@@ -11,15 +14,35 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/audit"
+	"github.com/nrz-incubator/malygos/pkg/malygos/auth"
 	"github.com/nrz-incubator/malygos/pkg/malygos/manager"
+	"github.com/nrz-incubator/malygos/pkg/malygos/rbac"
+	"github.com/nrz-incubator/malygos/pkg/malygos/tokenmanager"
 	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes"
 )
 
+const htpasswdReloadInterval = 30 * time.Second
+
 type Malygos struct {
 	http struct {
 		Port          int
 		EnableRecover bool
 	}
+	auth struct {
+		jwt               auth.JWTConfig
+		htpasswdFile      string
+		htpasswdSecret    string
+		htpasswdSecretKey string
+	}
+	tls struct {
+		certFile     string
+		keyFile      string
+		clientCAFile string
+	}
+	rbac                rbac.Config
+	audit               audit.Config
 	kubeconfig          string
 	managementNamespace string
 	manager             api.Manager
@@ -39,6 +62,9 @@ func New() *Malygos {
 }
 
 func (m *Malygos) Run() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	zapLog, err := zap.NewProduction()
 	if err != nil {
 		return err
@@ -52,6 +78,7 @@ func (m *Malygos) Run() error {
 	}
 
 	e := echo.New()
+	e.Use(middleware.RequestID())
 	e.Use(middleware.LoggerWithConfig(loggerConfig()))
 	if m.http.EnableRecover {
 		e.Use(middleware.Recover())
@@ -60,21 +87,102 @@ func (m *Malygos) Run() error {
 	p := prometheus.NewPrometheus("echo", nil)
 	p.Use(e)
 
-	m.manager, err = manager.NewMalygosManager(m.logger, m.kubeconfig, m.managementNamespace)
+	m.manager, err = manager.NewMalygosManager(ctx, m.logger, m.kubeconfig, m.managementNamespace, m.rbac, m.audit)
+	if err != nil {
+		return err
+	}
+
+	authenticators, err := m.buildAuthenticators(ctx)
 	if err != nil {
+		m.logger.Error(err, "failed to configure authentication")
 		return err
 	}
 
+	if len(authenticators) > 0 {
+		e.Use(auth.Middleware(m.logger, auth.DefaultSkipper, authenticators...))
+	} else {
+		m.logger.Info("no authentication method configured, all requests are anonymous")
+	}
+	e.Use(auth.ImpersonationMiddleware(m.logger.WithName("impersonation"), auth.DefaultSkipper, m.manager.GetRBAC()))
+
 	myAPI := api.NewApiImpl(m.logger, m.manager)
 	api.RegisterHandlers(e, myAPI)
 
 	// TODO: CORS
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{},
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
+		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization,
+			api.ImpersonateUserHeader, api.ImpersonateGroupHeader, api.LastEventIDHeader},
 	}))
 
-	return e.Start(fmt.Sprintf(":%d", m.http.Port))
+	address := fmt.Sprintf(":%d", m.http.Port)
+	if m.tls.certFile == "" {
+		return e.Start(address)
+	}
+
+	reloader, err := newTLSReloader(m.logger.WithName("tls"), m.tls.certFile, m.tls.keyFile, m.tls.clientCAFile)
+	if err != nil {
+		m.logger.Error(err, "failed to configure TLS")
+		return err
+	}
+
+	return e.StartServer(&http.Server{
+		Addr:      address,
+		TLSConfig: reloader.TLSConfig(),
+	})
+}
+
+func (m *Malygos) buildAuthenticators(ctx context.Context) ([]auth.Authenticator, error) {
+	authenticators := []auth.Authenticator{}
+
+	if m.tls.clientCAFile != "" {
+		authenticators = append(authenticators, auth.NewClientCertificateAuthenticator())
+	}
+
+	var htpasswdSource auth.HtpasswdSource
+	if m.auth.htpasswdFile != "" {
+		htpasswdSource = auth.NewHtpasswdFileSource(m.auth.htpasswdFile)
+	} else if m.auth.htpasswdSecret != "" {
+		client, err := kubernetes.NewForConfig(m.manager.GetKubeconfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create k8s client: %v", err)
+		}
+
+		htpasswdSource = auth.NewHtpasswdSecretSource(client, m.managementNamespace, m.auth.htpasswdSecret, m.auth.htpasswdSecretKey)
+	}
+
+	if htpasswdSource != nil {
+		basicAuthenticator, err := auth.NewBasicAuthenticator(m.logger.WithName("basic-auth"), htpasswdSource)
+		if err != nil {
+			return nil, err
+		}
+
+		go basicAuthenticator.Run(ctx, htpasswdReloadInterval)
+		authenticators = append(authenticators, basicAuthenticator)
+	}
+
+	if m.auth.jwt.JWKS != "" {
+		jwtAuthenticator, err := auth.NewJWTAuthenticator(m.auth.jwt)
+		if err != nil {
+			return nil, err
+		}
+
+		authenticators = append(authenticators, jwtAuthenticator)
+	}
+
+	// API tokens are issued to authenticated users, they are useless without another method.
+	// They go first as the JWT authenticator rejects every bearer token it can't parse.
+	if len(authenticators) > 0 {
+		tokens := m.manager.GetTokenManager()
+		for i, authenticator := range authenticators {
+			authenticators[i] = auth.NewTokenOwnerAuthenticator(authenticator, tokens)
+		}
+
+		tokenAuthenticator := auth.NewTokenAuthenticator(tokenmanager.TokenPrefix, tokens)
+		authenticators = append([]auth.Authenticator{tokenAuthenticator}, authenticators...)
+	}
+
+	return authenticators, nil
 }
 
 func loggerConfig() middleware.LoggerConfig {
@@ -84,12 +192,19 @@ func loggerConfig() middleware.LoggerConfig {
 		`"status":${status},"error":"${error}","latency":${latency},"latency_human":"${latency_human}"` +
 		`,"bytes_in":${bytes_in},"bytes_out":${bytes_out},"custom":${custom}}` + "\n"
 	loggerConfig.CustomTagFunc = func(c echo.Context, buf *bytes.Buffer) (int, error) {
-		switch v := c.Get("username").(type) {
+		switch v := c.Get(api.UsernameContextKey).(type) {
 		case string:
+			impersonator := ""
+			if user := api.GetImpersonator(c); user != nil {
+				impersonator = user.Username
+			}
+
 			b, err := json.Marshal(struct {
-				Username string `json:"username"`
+				Username     string `json:"username"`
+				Impersonator string `json:"impersonator,omitempty"`
 			}{
-				Username: v,
+				Username:     v,
+				Impersonator: impersonator,
 			})
 
 			if err != nil {
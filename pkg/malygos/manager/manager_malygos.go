@@ -1,15 +1,22 @@
 package manager
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-logr/logr"
 	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/audit"
 	"github.com/nrz-incubator/malygos/pkg/errors"
 	"github.com/nrz-incubator/malygos/pkg/malygos/catalogmanager"
 	"github.com/nrz-incubator/malygos/pkg/malygos/clustermanager"
 	"github.com/nrz-incubator/malygos/pkg/malygos/clusterregistrar"
+	"github.com/nrz-incubator/malygos/pkg/malygos/clusterwatcher"
+	"github.com/nrz-incubator/malygos/pkg/malygos/operationmanager"
+	"github.com/nrz-incubator/malygos/pkg/malygos/quotamanager"
 	"github.com/nrz-incubator/malygos/pkg/malygos/rbac"
+	"github.com/nrz-incubator/malygos/pkg/malygos/tokenmanager"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -22,10 +29,17 @@ type MalygosManager struct {
 	logger           logr.Logger
 	rbac             api.RBAC
 	catalogManager   api.CatalogManager
+	tokenManager     api.TokenManager
+	auditor          *audit.Auditor
+	quotaManager     api.QuotaManager
+	clusterWatcher   api.ClusterWatcher
+	operationManager *operationmanager.InKubeOperationManager
+	providers        *clustermanager.Registry
 	namespace        string
 }
 
-func NewMalygosManager(logger logr.Logger, kubeconfig string, namespace string) (*MalygosManager, error) {
+func NewMalygosManager(ctx context.Context, logger logr.Logger, kubeconfig string, namespace string, rbacConfig rbac.Config,
+	auditConfig audit.Config) (*MalygosManager, error) {
 	config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build k8s config: %v", err)
@@ -46,14 +60,47 @@ func NewMalygosManager(logger logr.Logger, kubeconfig string, namespace string)
 		return nil, fmt.Errorf("failed to create catalog manager: %v", err)
 	}
 
-	return &MalygosManager{
+	rbacBackend, err := rbac.New(ctx, logger.WithName("rbac"), config, rbacConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RBAC backend: %v", err)
+	}
+
+	auditor, err := audit.New(ctx, logger.WithName("audit"), auditConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create auditor: %v", err)
+	}
+
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create k8s client: %v", err)
+	}
+
+	providers := clustermanager.NewDefaultRegistry()
+	m := &MalygosManager{
 		kubeConfig:       config,
 		registrarManager: registarManager,
 		logger:           logger,
-		rbac:             rbac.NewNoop(),
+		rbac:             rbacBackend,
 		namespace:        namespace,
 		catalogManager:   catalogManager,
-	}, nil
+		auditor:          auditor,
+		quotaManager:     quotamanager.NewInKubeQuotaManager(dynamicClient, namespace),
+		tokenManager:     tokenmanager.NewInKubeTokenManager(logger.WithName("tokens"), client, namespace),
+		clusterWatcher: clusterwatcher.NewInformerClusterWatcher(ctx, logger.WithName("watch"), registarManager, namespace,
+			func(registrar *api.ClusterRegistrar) (schema.GroupVersionResource, clusterwatcher.Converter, error) {
+				provider, err := providers.Get(registrar.GetProvider())
+				if err != nil {
+					return schema.GroupVersionResource{}, nil, err
+				}
+				return provider.Resource, provider.Convert, nil
+			}),
+		operationManager: operationmanager.NewInKubeOperationManager(logger.WithName("operations"), client, namespace),
+		providers:        providers,
+	}
+
+	go m.operationManager.Run(ctx, operationmanager.DefaultTrackInterval, m.GetClusterManager)
+
+	return m, nil
 }
 
 func (m *MalygosManager) GetKubeconfig() *rest.Config {
@@ -83,13 +130,65 @@ func (m *MalygosManager) GetClusterManager(region string) (api.ClusterManager, e
 		return nil, fmt.Errorf("failed to create k8s client for management cluster: %v", err)
 	}
 
-	return m.InstanciateClusterManager(m.logger, nil, client), nil
+	return m.InstanciateClusterManager(m.logger, registar, client)
+}
+
+func (m *MalygosManager) InstanciateClusterManager(logger logr.Logger, registrar *api.ClusterRegistrar, client dynamic.Interface) (api.ClusterManager, error) {
+	provider, err := m.providers.Get(registrar.GetProvider())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get provider of region %s: %v", registrar.Region, err)
+	}
+
+	clusterManager, err := provider.New(logger.WithValues("provider", provider.Name), registrar, client, m.namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s cluster manager of region %s: %v", provider.Name, registrar.Region, err)
+	}
+
+	return clusterManager, nil
+}
+
+func (m *MalygosManager) GetClusterCapabilities(region string) (*api.ClusterCapabilities, error) {
+	registar, err := m.registrarManager.Get(region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get management cluster for region %s: %v", region, err)
+	}
+
+	if registar == nil {
+		return nil, errors.NewNotFoundError("management cluster for region", region)
+	}
+
+	provider, err := m.providers.Get(registar.GetProvider())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get provider of region %s: %v", region, err)
+	}
+
+	return &provider.Capabilities, nil
 }
 
-func (m *MalygosManager) InstanciateClusterManager(logger logr.Logger, _ *kubernetes.Clientset, client *dynamic.DynamicClient) api.ClusterManager {
-	return clustermanager.NewKamajiClusterManager(logger, client, m.namespace)
+func (m *MalygosManager) GetClusterProviders() []string {
+	return m.providers.Names()
 }
 
 func (m *MalygosManager) GetCatalog() api.CatalogManager {
 	return m.catalogManager
 }
+
+func (m *MalygosManager) GetTokenManager() api.TokenManager {
+	return m.tokenManager
+}
+
+func (m *MalygosManager) GetAuditor() *audit.Auditor {
+	return m.auditor
+}
+
+func (m *MalygosManager) GetQuotaManager() api.QuotaManager {
+	return m.quotaManager
+}
+
+func (m *MalygosManager) GetClusterWatcher() api.ClusterWatcher {
+	return m.clusterWatcher
+}
+
+func (m *MalygosManager) GetOperationManager() api.OperationManager {
+	return m.operationManager
+}
@@ -0,0 +1,306 @@
+package operationmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/nrz-incubator/malygos/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// DefaultTrackInterval is the period at which running operations are checked against their cluster
+	DefaultTrackInterval = 10 * time.Second
+	// DefaultRetention is how long finished operations are kept
+	DefaultRetention = 7 * 24 * time.Hour
+
+	operationLabel      = "malygos.local/operation"
+	stateLabel          = "malygos.local/operation-state"
+	ownerAnnotation     = "malygos.local/owner"
+	configMapNamePrefix = "malygos-operation-"
+	configMapRecordKey  = "operation"
+	operationIDLength   = 12
+)
+
+// ClusterManagerGetter returns the cluster manager of a region, like api.Manager.GetClusterManager
+type ClusterManagerGetter func(region string) (api.ClusterManager, error)
+
+// InKubeOperationManager stores each operation as a ConfigMap of the management namespace.
+type InKubeOperationManager struct {
+	client    kubernetes.Interface
+	logger    logr.Logger
+	namespace string
+	retention time.Duration
+}
+
+func NewInKubeOperationManager(logger logr.Logger, client kubernetes.Interface, namespace string) *InKubeOperationManager {
+	return &InKubeOperationManager{
+		client:    client,
+		logger:    logger,
+		namespace: namespace,
+		retention: DefaultRetention,
+	}
+}
+
+func (m *InKubeOperationManager) Create(operation *api.Operation) (*api.Operation, error) {
+	operation.Id = util.GenerateRandomString(operationIDLength)
+
+	configMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapNamePrefix + operation.Id,
+			Namespace: m.namespace,
+			Labels: map[string]string{
+				operationLabel: "true",
+			},
+		},
+	}
+	if err := encodeOperation(configMap, operation); err != nil {
+		return nil, err
+	}
+
+	_, err := m.client.CoreV1().ConfigMaps(m.namespace).Create(context.TODO(), configMap, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create operation config map: %v", err)
+	}
+
+	return operation, nil
+}
+
+func (m *InKubeOperationManager) Get(id string) (*api.Operation, error) {
+	_, operation, err := m.get(id)
+	return operation, err
+}
+
+func (m *InKubeOperationManager) List(owner string, state *api.OperationState) ([]api.Operation, error) {
+	selector := operationLabel + "=true"
+	if state != nil {
+		selector += fmt.Sprintf(",%s=%s", stateLabel, *state)
+	}
+
+	configMaps, err := m.client.CoreV1().ConfigMaps(m.namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list operation config maps: %v", err)
+	}
+
+	operations := []api.Operation{}
+	for _, configMap := range configMaps.Items {
+		if owner != "" && configMap.Annotations[ownerAnnotation] != owner {
+			continue
+		}
+
+		operation, err := decodeOperation(&configMap)
+		if err != nil {
+			m.logger.Error(err, "ignoring malformed operation config map", "configmap", configMap.Name)
+			continue
+		}
+
+		operations = append(operations, *operation)
+	}
+
+	slices.SortFunc(operations, func(a, b api.Operation) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	return operations, nil
+}
+
+func (m *InKubeOperationManager) Update(operation *api.Operation) error {
+	configMap, _, err := m.get(operation.Id)
+	if err != nil {
+		return err
+	}
+
+	return m.update(configMap, operation)
+}
+
+func (m *InKubeOperationManager) Delete(id string) error {
+	if _, _, err := m.get(id); err != nil {
+		return err
+	}
+
+	err := m.client.CoreV1().ConfigMaps(m.namespace).Delete(context.TODO(), configMapNamePrefix+id, metav1.DeleteOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return errors.NewNotFoundError("operation", id)
+		}
+
+		return fmt.Errorf("failed to delete operation config map: %v", err)
+	}
+
+	return nil
+}
+
+// Run tracks the running operations until ctx is done. Several instances can run it at once as
+// updates are guarded by the resource version of the operations.
+func (m *InKubeOperationManager) Run(ctx context.Context, interval time.Duration, clusters ClusterManagerGetter) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.track(clusters)
+		}
+	}
+}
+
+func (m *InKubeOperationManager) track(clusters ClusterManagerGetter) {
+	configMaps, err := m.client.CoreV1().ConfigMaps(m.namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: operationLabel + "=true",
+	})
+	if err != nil {
+		m.logger.Error(err, "failed to list operations")
+		return
+	}
+
+	now := time.Now().UTC()
+	for _, configMap := range configMaps.Items {
+		operation, err := decodeOperation(&configMap)
+		if err != nil {
+			m.logger.Error(err, "ignoring malformed operation config map", "configmap", configMap.Name)
+			continue
+		}
+
+		logger := m.logger.WithValues("operation", operation.Id)
+		if operation.State != api.Running {
+			if operation.FinishedAt != nil && now.Sub(*operation.FinishedAt) > m.retention {
+				err = m.client.CoreV1().ConfigMaps(m.namespace).Delete(context.TODO(), configMap.Name, metav1.DeleteOptions{
+					Preconditions: &metav1.Preconditions{ResourceVersion: &configMap.ResourceVersion},
+				})
+				if err != nil && !k8serrors.IsNotFound(err) && !k8serrors.IsConflict(err) {
+					logger.Error(err, "failed to delete expired operation")
+				}
+			}
+			continue
+		}
+
+		if !m.observe(logger, now, operation, clusters) {
+			continue
+		}
+
+		// another instance may have updated the operation first, it is checked again on the next run
+		if err := m.update(&configMap, operation); err != nil && !errors.IsModified(err) {
+			logger.Error(err, "failed to update operation")
+		}
+	}
+}
+
+// observe advances a running operation from its cluster and reports whether it changed
+func (m *InKubeOperationManager) observe(logger logr.Logger, now time.Time, operation *api.Operation,
+	clusters ClusterManagerGetter) bool {
+	// the cluster is recorded once submitted to the region, a missing one means the submission was
+	// interrupted
+	if operation.ClusterId == nil {
+		return operation.Expire(now)
+	}
+
+	clusterManager, err := clusters(operation.Region)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			operation.Fail(now, fmt.Errorf("region %s not found", operation.Region))
+			return true
+		}
+
+		logger.Error(err, "failed to get cluster manager", "region", operation.Region)
+		return operation.Expire(now)
+	}
+
+	cluster, err := clusterManager.Get(*operation.ClusterId)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			logger.Error(err, "failed to get cluster", "region", operation.Region, "id", *operation.ClusterId)
+			return operation.Expire(now)
+		}
+		cluster = nil
+	}
+
+	return operation.Observe(now, cluster)
+}
+
+func (m *InKubeOperationManager) get(id string) (*v1.ConfigMap, *api.Operation, error) {
+	configMap, err := m.client.CoreV1().ConfigMaps(m.namespace).Get(context.TODO(), configMapNamePrefix+id, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil, errors.NewNotFoundError("operation", id)
+		}
+
+		return nil, nil, fmt.Errorf("failed to get operation config map: %v", err)
+	}
+
+	if configMap.Labels[operationLabel] != "true" {
+		return nil, nil, errors.NewNotFoundError("operation", id)
+	}
+
+	operation, err := decodeOperation(configMap)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return configMap, operation, nil
+}
+
+func (m *InKubeOperationManager) update(configMap *v1.ConfigMap, operation *api.Operation) error {
+	configMap = configMap.DeepCopy()
+	if err := encodeOperation(configMap, operation); err != nil {
+		return err
+	}
+
+	_, err := m.client.CoreV1().ConfigMaps(m.namespace).Update(context.TODO(), configMap, metav1.UpdateOptions{})
+	if err != nil {
+		if k8serrors.IsConflict(err) {
+			return errors.NewModifiedError("operation", operation.Id)
+		}
+
+		return fmt.Errorf("failed to update operation config map: %v", err)
+	}
+
+	return nil
+}
+
+// encodeOperation stores the operation in the config map, the owner and state are also kept as
+// metadata to filter on them
+func encodeOperation(configMap *v1.ConfigMap, operation *api.Operation) error {
+	b, err := json.Marshal(operation)
+	if err != nil {
+		return fmt.Errorf("failed to marshal operation: %v", err)
+	}
+
+	if configMap.Labels == nil {
+		configMap.Labels = map[string]string{}
+	}
+	configMap.Labels[stateLabel] = string(operation.State)
+
+	if configMap.Annotations == nil {
+		configMap.Annotations = map[string]string{}
+	}
+	configMap.Annotations[ownerAnnotation] = operation.Owner
+
+	configMap.Data = map[string]string{
+		configMapRecordKey: string(b),
+	}
+
+	return nil
+}
+
+func decodeOperation(configMap *v1.ConfigMap) (*api.Operation, error) {
+	operation := &api.Operation{}
+	if err := json.Unmarshal([]byte(configMap.Data[configMapRecordKey]), operation); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal operation %s: %v", strings.TrimPrefix(configMap.Name, configMapNamePrefix), err)
+	}
+
+	return operation, nil
+}
@@ -0,0 +1,106 @@
+package operationmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+type fakeClusterManager struct {
+	api.ClusterManager
+	clusters map[string]*api.Cluster
+}
+
+func (f *fakeClusterManager) Get(id string) (*api.Cluster, error) {
+	cluster, ok := f.clusters[id]
+	if !ok {
+		return nil, errors.NewNotFoundError("cluster", id)
+	}
+
+	return cluster, nil
+}
+
+func Test_InKubeOperationManager(t *testing.T) {
+	m := NewInKubeOperationManager(logr.Discard(), fake.NewSimpleClientset(), "malygos")
+
+	create, err := m.Create(api.NewOperation(api.Create, "eu", "alice"))
+	require.NoError(t, err)
+	require.NotEmpty(t, create.Id)
+	create.ClusterId = ptr.To("a")
+	require.NoError(t, m.Update(create))
+
+	deletion := api.NewOperation(api.Delete, "eu", "alice")
+	deletion.ClusterId = ptr.To("b")
+	deletion.CreatedAt = deletion.CreatedAt.Add(time.Second)
+	_, err = m.Create(deletion)
+	require.NoError(t, err)
+
+	_, err = m.Create(api.NewOperation(api.Create, "us", "bob"))
+	require.NoError(t, err)
+
+	operations, err := m.List("alice", nil)
+	require.NoError(t, err)
+	require.Len(t, operations, 2)
+	assert.Equal(t, deletion.Id, operations[0].Id, "operations are listed most recent first")
+
+	operations, err = m.List("", nil)
+	require.NoError(t, err)
+	assert.Len(t, operations, 3, "an empty owner lists every operation")
+
+	_, err = m.Get("unknown")
+	assert.True(t, errors.IsNotFound(err))
+
+	clusters := &fakeClusterManager{clusters: map[string]*api.Cluster{
+		"a": {Id: ptr.To("a"), Status: &api.ClusterStatus{Phase: "Ready", Online: true}},
+	}}
+	m.track(func(region string) (api.ClusterManager, error) {
+		if region != "eu" {
+			return nil, errors.NewNotFoundError("management cluster for region", region)
+		}
+		return clusters, nil
+	})
+
+	create, err = m.Get(create.Id)
+	require.NoError(t, err)
+	assert.Equal(t, api.Succeeded, create.State)
+	require.NotNil(t, create.Result)
+	assert.Equal(t, "a", *create.Result.Id)
+
+	deletion, err = m.Get(deletion.Id)
+	require.NoError(t, err)
+	assert.Equal(t, api.Succeeded, deletion.State)
+
+	succeeded := api.Succeeded
+	operations, err = m.List("alice", &succeeded)
+	require.NoError(t, err)
+	assert.Len(t, operations, 2)
+
+	// an operation without cluster keeps running until it times out
+	operations, err = m.List("bob", nil)
+	require.NoError(t, err)
+	require.Len(t, operations, 1)
+	assert.Equal(t, api.Running, operations[0].State)
+
+	require.NoError(t, m.Delete(operations[0].Id))
+	assert.True(t, errors.IsNotFound(m.Delete(operations[0].Id)))
+	operations, err = m.List("bob", nil)
+	require.NoError(t, err)
+	assert.Empty(t, operations)
+
+	// finished operations are removed after the retention
+	m.retention = 0
+	time.Sleep(10 * time.Millisecond)
+	m.track(func(region string) (api.ClusterManager, error) {
+		return clusters, nil
+	})
+	operations, err = m.List("alice", nil)
+	require.NoError(t, err)
+	assert.Empty(t, operations)
+}
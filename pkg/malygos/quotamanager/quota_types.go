@@ -0,0 +1,28 @@
+package quotamanager
+
+import (
+	malygosv1 "github.com/nrz-incubator/malygos-controller/api/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Quotas are not managed by malygos-controller, their CRD is shipped in config/crd and lives
+// in the same API group as the other Malygos resources.
+var QuotaResource = malygosv1.GroupVersion.WithResource("quotas")
+
+// QuotaSpec limits the control planes of a team in a region, empty selectors match everything.
+type QuotaSpec struct {
+	Team        string             `json:"team,omitempty"`
+	Region      string             `json:"region,omitempty"`
+	MaxClusters *int64             `json:"maxClusters,omitempty"`
+	MaxReplicas *int64             `json:"maxReplicas,omitempty"`
+	MaxCPU      *resource.Quantity `json:"maxCPU,omitempty"`
+	MaxMemory   *resource.Quantity `json:"maxMemory,omitempty"`
+}
+
+type Quota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec QuotaSpec `json:"spec,omitempty"`
+}
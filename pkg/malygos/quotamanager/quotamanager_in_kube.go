@@ -0,0 +1,72 @@
+package quotamanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/ptr"
+)
+
+type InKubeQuotaManager struct {
+	client    dynamic.Interface
+	namespace string
+}
+
+func NewInKubeQuotaManager(client dynamic.Interface, namespace string) *InKubeQuotaManager {
+	return &InKubeQuotaManager{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+func (m *InKubeQuotaManager) List() ([]api.Quota, error) {
+	unstructuredList, err := m.client.Resource(QuotaResource).
+		Namespace(m.namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quotas: %v", err)
+	}
+
+	quotas := []api.Quota{}
+	for _, item := range unstructuredList.Items {
+		var quota Quota
+		if err := util.ConvertUnstructured(&item, &quota); err != nil {
+			return nil, fmt.Errorf("failed to convert quota %s: %v", item.GetName(), err)
+		}
+
+		quotas = append(quotas, toAPIQuota(&quota))
+	}
+
+	return quotas, nil
+}
+
+func toAPIQuota(quota *Quota) api.Quota {
+	apiQuota := api.Quota{
+		Name: quota.Name,
+		Limits: api.QuotaLimits{
+			Clusters: quota.Spec.MaxClusters,
+			Replicas: quota.Spec.MaxReplicas,
+		},
+	}
+
+	if quota.Spec.Team != "" {
+		apiQuota.Team = ptr.To(quota.Spec.Team)
+	}
+
+	if quota.Spec.Region != "" {
+		apiQuota.Region = ptr.To(quota.Spec.Region)
+	}
+
+	if quota.Spec.MaxCPU != nil {
+		apiQuota.Limits.Cpu = ptr.To(quota.Spec.MaxCPU.String())
+	}
+
+	if quota.Spec.MaxMemory != nil {
+		apiQuota.Limits.Memory = ptr.To(quota.Spec.MaxMemory.String())
+	}
+
+	return apiQuota
+}
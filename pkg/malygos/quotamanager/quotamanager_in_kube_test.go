@@ -0,0 +1,41 @@
+package quotamanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func Test_InKubeQuotaManager(t *testing.T) {
+	quota := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": QuotaResource.GroupVersion().String(),
+		"kind":       "Quota",
+		"metadata": map[string]interface{}{
+			"name":      "team-a",
+			"namespace": "malygos",
+		},
+		"spec": map[string]interface{}{
+			"team":        "team-a",
+			"maxClusters": int64(5),
+			"maxMemory":   "16Gi",
+		},
+	}}
+
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{QuotaResource: "QuotaList"}, quota)
+
+	quotas, err := NewInKubeQuotaManager(client, "malygos").List()
+	require.NoError(t, err)
+	require.Len(t, quotas, 1)
+	assert.Equal(t, "team-a", quotas[0].Name)
+	assert.Equal(t, "team-a", *quotas[0].Team)
+	assert.Nil(t, quotas[0].Region)
+	assert.Equal(t, int64(5), *quotas[0].Limits.Clusters)
+	assert.Equal(t, "16Gi", *quotas[0].Limits.Memory)
+	assert.Nil(t, quotas[0].Limits.Cpu)
+}
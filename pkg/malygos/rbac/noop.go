@@ -1,11 +1,13 @@
 package rbac
 
+import "github.com/nrz-incubator/malygos/pkg/api"
+
 type NoopRBAC struct{}
 
 func NewNoop() *NoopRBAC {
 	return &NoopRBAC{}
 }
 
-func (n *NoopRBAC) IsAllowed(username, ressource, action string) bool {
+func (n *NoopRBAC) IsAllowed(_ *api.UserInfo, _, _, _, _ string) bool {
 	return true
 }
@@ -0,0 +1,248 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/util"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	DefaultPolicyReloadInterval = 10 * time.Second
+	wildcard                    = "*"
+)
+
+// regionalResources are listed across regions by the API, which filters the results per region and object
+var regionalResources = map[string]bool{
+	"cluster":           true,
+	"managementcluster": true,
+	"operation":         true,
+}
+
+// Policy grants actions on resources to users and groups through roles.
+//
+// Rules may be scoped to regions and object IDs. An empty list or "*" matches everything.
+// Checks which are not bound to a region or an object only match unscoped rules, except listings
+// of regional resources which match scoped rules too: the API filters their results with
+// per-region and per-object checks.
+type Policy struct {
+	Roles    []PolicyRole    `json:"roles"`
+	Bindings []PolicyBinding `json:"bindings"`
+}
+
+type PolicyRole struct {
+	Name  string       `json:"name"`
+	Rules []PolicyRule `json:"rules"`
+}
+
+type PolicyRule struct {
+	Actions   []string `json:"actions"`
+	Resources []string `json:"resources"`
+	Regions   []string `json:"regions,omitempty"`
+	IDs       []string `json:"ids,omitempty"`
+}
+
+type PolicyBinding struct {
+	Role   string   `json:"role"`
+	Users  []string `json:"users,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+}
+
+func (p *Policy) validate() error {
+	roles := map[string]bool{}
+	for _, role := range p.Roles {
+		if role.Name == "" {
+			return fmt.Errorf("role name is required")
+		}
+
+		if roles[role.Name] {
+			return fmt.Errorf("role %s is defined twice", role.Name)
+		}
+		roles[role.Name] = true
+
+		for i, rule := range role.Rules {
+			if len(rule.Actions) == 0 || len(rule.Resources) == 0 {
+				return fmt.Errorf("role %s rule %d must have actions and resources", role.Name, i)
+			}
+		}
+	}
+
+	for _, binding := range p.Bindings {
+		if !roles[binding.Role] {
+			return fmt.Errorf("binding references unknown role %s", binding.Role)
+		}
+	}
+
+	return nil
+}
+
+func (p *Policy) isAllowed(user *api.UserInfo, action, resource, region, id string) bool {
+	for _, binding := range p.Bindings {
+		if !binding.matches(user) {
+			continue
+		}
+
+		for _, role := range p.Roles {
+			if role.Name != binding.Role {
+				continue
+			}
+
+			for _, rule := range role.Rules {
+				if rule.matches(action, resource, region, id) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func (b *PolicyBinding) matches(user *api.UserInfo) bool {
+	for _, u := range b.Users {
+		if u == user.Username || u == wildcard {
+			return true
+		}
+	}
+
+	for _, g := range b.Groups {
+		for _, userGroup := range user.Groups {
+			if g == userGroup {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (r *PolicyRule) matches(action, resource, region, id string) bool {
+	filtered := action == "list" && regionalResources[resource]
+	return contains(r.Actions, action) &&
+		contains(r.Resources, resource) &&
+		scoped(r.Regions, region, filtered) &&
+		scoped(r.IDs, id, filtered)
+}
+
+// scoped reports whether a scope of a rule matches value. An empty value only matches unscoped
+// rules, unless listing is set.
+func scoped(values []string, value string, listing bool) bool {
+	if len(values) == 0 || (value == "" && listing) {
+		return true
+	}
+
+	if value == "" {
+		return contains(values, wildcard)
+	}
+
+	return contains(values, value)
+}
+
+// contains reports whether value is in values or values has a wildcard.
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value || v == wildcard {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PolicyRBAC is an api.RBAC backend reading its policy from a YAML file, reloaded when it changes.
+type PolicyRBAC struct {
+	logger logr.Logger
+	path   string
+
+	lock     sync.RWMutex
+	policy   *Policy
+	revision string
+}
+
+func NewPolicy(logger logr.Logger, path string) (*PolicyRBAC, error) {
+	r := &PolicyRBAC{
+		logger: logger,
+		path:   path,
+	}
+
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *PolicyRBAC) IsAllowed(user *api.UserInfo, action, resource, region, id string) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.policy.isAllowed(user, action, resource, region, id)
+}
+
+// Run reloads the policy whenever the file changes, until the context is done.
+// An invalid policy is logged and the previous one is kept.
+func (r *PolicyRBAC) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			revision, err := util.FileRevision(r.path)
+			if err != nil {
+				r.logger.Error(err, "failed to check policy revision")
+				continue
+			}
+
+			r.lock.RLock()
+			changed := revision != r.revision
+			r.lock.RUnlock()
+
+			if !changed {
+				continue
+			}
+
+			if err := r.reload(); err != nil {
+				r.logger.Error(err, "failed to reload policy, keeping previous one")
+				continue
+			}
+
+			r.logger.Info("policy reloaded")
+		}
+	}
+}
+
+func (r *PolicyRBAC) reload() error {
+	revision, err := util.FileRevision(r.path)
+	if err != nil {
+		return fmt.Errorf("failed to read policy %s: %v", r.path, err)
+	}
+
+	b, err := os.ReadFile(r.path)
+	if err != nil {
+		return fmt.Errorf("failed to read policy %s: %v", r.path, err)
+	}
+
+	policy := &Policy{}
+	if err := yaml.UnmarshalStrict(b, policy); err != nil {
+		return fmt.Errorf("failed to parse policy %s: %v", r.path, err)
+	}
+
+	if err := policy.validate(); err != nil {
+		return fmt.Errorf("invalid policy %s: %v", r.path, err)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.policy = policy
+	r.revision = revision
+	return nil
+}
@@ -0,0 +1,125 @@
+package rbac
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPolicy = `
+roles:
+  - name: eu-operator
+    rules:
+      - actions: [create, list, get, delete]
+        resources: [cluster]
+        regions: [eu-west-1]
+      - actions: [list, get]
+        resources: [catalog_component]
+  - name: admin
+    rules:
+      - actions: ["*"]
+        resources: ["*"]
+bindings:
+  - role: eu-operator
+    groups: [ops]
+  - role: admin
+    users: [root]
+`
+
+func Test_PolicyRBAC(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testPolicy), 0o600))
+
+	r, err := NewPolicy(logr.Discard(), path)
+	require.NoError(t, err)
+
+	alice := &api.UserInfo{Username: "alice", Groups: []string{"ops"}}
+	root := &api.UserInfo{Username: "root"}
+	bob := &api.UserInfo{Username: "bob"}
+
+	assert.True(t, r.IsAllowed(alice, "create", "cluster", "eu-west-1", ""))
+	assert.True(t, r.IsAllowed(alice, "list", "cluster", "", ""))
+	assert.False(t, r.IsAllowed(alice, "create", "cluster", "us-east-1", ""))
+	assert.False(t, r.IsAllowed(alice, "subscribe", "catalog_component_version", "eu-west-1", "nginx"))
+	assert.True(t, r.IsAllowed(alice, "get", "catalog_component", "", "nginx"))
+	assert.True(t, r.IsAllowed(root, "delete", "managementcluster", "us-east-1", ""))
+	assert.False(t, r.IsAllowed(bob, "list", "cluster", "", ""))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go r.Run(ctx, 10*time.Millisecond)
+
+	require.NoError(t, os.WriteFile(path, []byte(`
+roles:
+  - name: reader
+    rules:
+      - actions: [list]
+        resources: [cluster]
+        ids: [malygos-abc]
+bindings:
+  - role: reader
+    users: [bob]
+`), 0o600))
+
+	assert.Eventually(t, func() bool {
+		return r.IsAllowed(bob, "list", "cluster", "eu-west-1", "malygos-abc")
+	}, time.Second, 10*time.Millisecond)
+	assert.False(t, r.IsAllowed(bob, "list", "cluster", "eu-west-1", "malygos-def"))
+	assert.False(t, r.IsAllowed(root, "delete", "managementcluster", "us-east-1", ""))
+
+	// invalid policies are rejected and the previous policy is kept
+	require.NoError(t, os.WriteFile(path, []byte("bindings:\n  - role: unknown\n    users: [bob]\n"), 0o600))
+	time.Sleep(50 * time.Millisecond)
+	assert.True(t, r.IsAllowed(bob, "list", "cluster", "eu-west-1", "malygos-abc"))
+}
+
+func Test_PolicyRBACScopedRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+roles:
+  - name: eu-admin
+    rules:
+      - actions: ["*"]
+        resources: ["*"]
+        regions: [eu-west-1]
+  - name: impersonator
+    rules:
+      - actions: [impersonate]
+        resources: [user]
+        ids: [bob]
+bindings:
+  - role: eu-admin
+    users: [alice]
+  - role: impersonator
+    users: [carol]
+`), 0o600))
+
+	r, err := NewPolicy(logr.Discard(), path)
+	require.NoError(t, err)
+
+	alice := &api.UserInfo{Username: "alice"}
+	carol := &api.UserInfo{Username: "carol"}
+
+	// region scoped rules only grant listings of regional resources outside of their region
+	assert.True(t, r.IsAllowed(alice, "delete", "cluster", "eu-west-1", "malygos-abc"))
+	assert.True(t, r.IsAllowed(alice, "list", "cluster", "", ""))
+	assert.True(t, r.IsAllowed(alice, "list", "managementcluster", "", ""))
+	assert.False(t, r.IsAllowed(alice, "impersonate", "user", "", "root"))
+	assert.False(t, r.IsAllowed(alice, "create", "token", "", ""))
+	assert.False(t, r.IsAllowed(alice, "create", "serviceaccount_token", "", "ci"))
+	assert.False(t, r.IsAllowed(alice, "create", "catalog_component", "", "nginx"))
+	assert.False(t, r.IsAllowed(alice, "admin", "cluster", "", ""))
+	assert.False(t, r.IsAllowed(alice, "list", "audit", "", ""))
+
+	// ID scoped rules still apply to checks not bound to a region
+	assert.True(t, r.IsAllowed(carol, "impersonate", "user", "", "bob"))
+	assert.False(t, r.IsAllowed(carol, "impersonate", "user", "", "root"))
+	assert.False(t, r.IsAllowed(carol, "impersonate", "user", "", ""))
+}
@@ -0,0 +1,51 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	BackendNoop                = "noop"
+	BackendSubjectAccessReview = "subjectaccessreview"
+	BackendPolicy              = "policy"
+)
+
+type Config struct {
+	Backend    string
+	PolicyFile string
+}
+
+func New(ctx context.Context, logger logr.Logger, restConfig *rest.Config, config Config) (api.RBAC, error) {
+	switch config.Backend {
+	case "", BackendNoop:
+		logger.Info("RBAC is disabled, every request is allowed")
+		return NewNoop(), nil
+	case BackendSubjectAccessReview:
+		client, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create k8s client: %v", err)
+		}
+
+		return NewSubjectAccessReview(logger, client, DefaultSubjectAccessReviewCacheTTL), nil
+	case BackendPolicy:
+		if config.PolicyFile == "" {
+			return nil, fmt.Errorf("a policy file is required by the %s RBAC backend", BackendPolicy)
+		}
+
+		policy, err := NewPolicy(logger, config.PolicyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		go policy.Run(ctx, DefaultPolicyReloadInterval)
+		return policy, nil
+	default:
+		return nil, fmt.Errorf("unknown RBAC backend %s", config.Backend)
+	}
+}
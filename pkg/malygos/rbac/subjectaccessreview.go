@@ -0,0 +1,148 @@
+package rbac
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// APIGroup is the virtual API group used in Roles and ClusterRoles to grant Malygos permissions
+	APIGroup = "malygos.io"
+
+	DefaultSubjectAccessReviewCacheTTL = 10 * time.Second
+	subjectAccessReviewTimeout         = 5 * time.Second
+	subjectAccessReviewCacheMaxSize    = 4096
+)
+
+// resources maps the resource kinds used by the API handlers to Kubernetes style resource names
+var resources = map[string]string{
+	"cluster":                                "clusters",
+	"cluster_subscription":                   "clustersubscriptions",
+	"managementcluster":                      "managementclusters",
+	"catalog_component":                      "catalogcomponents",
+	"catalog_component_version":              "catalogcomponentversions",
+	"catalog_component_version_subscription": "catalogcomponentversionsubscriptions",
+	"token":                                  "tokens",
+	"serviceaccount_token":                   "serviceaccounttokens",
+	"audit":                                  "auditevents",
+	"quota":                                  "quotas",
+	"operation":                              "operations",
+	"user":                                   "users",
+	"group":                                  "groups",
+}
+
+type cachedReview struct {
+	allowed bool
+	expires time.Time
+}
+
+// SubjectAccessReviewRBAC delegates authorization decisions to the management cluster API server,
+// actions are mapped to verbs on resources of the malygos.io API group. The region is mapped to the
+// namespace and the object ID to the resource name, so that a RoleBinding in a namespace named after
+// a region only grants permissions in this region.
+type SubjectAccessReviewRBAC struct {
+	client kubernetes.Interface
+	logger logr.Logger
+	ttl    time.Duration
+
+	lock  sync.Mutex
+	cache map[string]cachedReview
+}
+
+func NewSubjectAccessReview(logger logr.Logger, client kubernetes.Interface, ttl time.Duration) *SubjectAccessReviewRBAC {
+	return &SubjectAccessReviewRBAC{
+		client: client,
+		logger: logger,
+		ttl:    ttl,
+		cache:  map[string]cachedReview{},
+	}
+}
+
+func (r *SubjectAccessReviewRBAC) IsAllowed(user *api.UserInfo, action, resource, region, id string) bool {
+	if name, ok := resources[resource]; ok {
+		resource = name
+	}
+
+	key := reviewCacheKey(user, action, resource, region, id)
+	if allowed, ok := r.cached(key); ok {
+		return allowed
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), subjectAccessReviewTimeout)
+	defer cancel()
+
+	review, err := r.client.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			Groups: user.Groups,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:     APIGroup,
+				Resource:  resource,
+				Verb:      action,
+				Namespace: region,
+				Name:      id,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		// errors are not cached so that the next request retries the review
+		r.logger.Error(err, "failed to create subject access review", "user", user.Username,
+			"action", action, "resource", resource)
+		return false
+	}
+
+	allowed := review.Status.Allowed && !review.Status.Denied
+	r.store(key, allowed)
+	return allowed
+}
+
+func (r *SubjectAccessReviewRBAC) cached(key string) (bool, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	entry, ok := r.cache[key]
+	if !ok || time.Now().After(entry.expires) {
+		return false, false
+	}
+
+	return entry.allowed, true
+}
+
+func (r *SubjectAccessReviewRBAC) store(key string, allowed bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := time.Now()
+	if len(r.cache) >= subjectAccessReviewCacheMaxSize {
+		for k, entry := range r.cache {
+			if now.After(entry.expires) {
+				delete(r.cache, k)
+			}
+		}
+	}
+
+	// still full of valid entries, start over rather than growing unbounded
+	if len(r.cache) >= subjectAccessReviewCacheMaxSize {
+		r.cache = map[string]cachedReview{}
+	}
+
+	r.cache[key] = cachedReview{
+		allowed: allowed,
+		expires: now.Add(r.ttl),
+	}
+}
+
+func reviewCacheKey(user *api.UserInfo, verb, resource, region, id string) string {
+	groups := append([]string{}, user.Groups...)
+	sort.Strings(groups)
+	return strings.Join([]string{user.Username, strings.Join(groups, ","), verb, resource, region, id}, "\x00")
+}
@@ -0,0 +1,39 @@
+package rbac
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func Test_SubjectAccessReviewRBAC(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	reviews := 0
+	client.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		reviews++
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		attributes := review.Spec.ResourceAttributes
+		review.Status.Allowed = attributes.Namespace == "" && attributes.Group == APIGroup && attributes.Resource == "clusters" &&
+			attributes.Verb == "list" && len(review.Spec.Groups) == 1 && review.Spec.Groups[0] == "ops"
+		return true, review, nil
+	})
+
+	r := NewSubjectAccessReview(logr.Discard(), client, time.Minute)
+	alice := &api.UserInfo{Username: "alice", Groups: []string{"ops"}}
+	bob := &api.UserInfo{Username: "bob"}
+
+	assert.True(t, r.IsAllowed(alice, "list", "cluster", "", ""))
+	assert.True(t, r.IsAllowed(alice, "list", "cluster", "", ""))
+	assert.Equal(t, 1, reviews, "second review must come from the cache")
+
+	assert.False(t, r.IsAllowed(alice, "delete", "cluster", "eu-west-1", "malygos-abc"))
+	assert.False(t, r.IsAllowed(bob, "list", "cluster", "", ""))
+	assert.Equal(t, 3, reviews)
+}
@@ -0,0 +1,137 @@
+package malygos
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/util"
+)
+
+// tlsReloader serves the current certificate and client CA bundle, reloading them whenever
+// their files change so that rotated certificates are picked up without a restart.
+type tlsReloader struct {
+	logger       logr.Logger
+	certFile     string
+	keyFile      string
+	clientCAFile string
+
+	lock     sync.Mutex
+	revision string
+	config   *tls.Config
+}
+
+func newTLSReloader(logger logr.Logger, certFile, keyFile, clientCAFile string) (*tlsReloader, error) {
+	r := &tlsReloader{
+		logger:       logger,
+		certFile:     certFile,
+		keyFile:      keyFile,
+		clientCAFile: clientCAFile,
+	}
+
+	if _, err := r.current(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// TLSConfig returns the server configuration, resolved on each handshake.
+func (r *tlsReloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(_ *tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.current()
+		},
+	}
+}
+
+func (r *tlsReloader) current() (*tls.Config, error) {
+	revision, err := r.fileRevisions()
+	if err != nil {
+		return r.fallback(err)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.config != nil && revision == r.revision {
+		return r.config, nil
+	}
+
+	config, err := r.load()
+	if err != nil {
+		if r.config != nil {
+			r.logger.Error(err, "failed to reload TLS configuration, keeping the previous one")
+			return r.config, nil
+		}
+
+		return nil, err
+	}
+
+	r.revision = revision
+	r.config = config
+	return config, nil
+}
+
+func (r *tlsReloader) fallback(err error) (*tls.Config, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.config == nil {
+		return nil, err
+	}
+
+	r.logger.Error(err, "failed to check TLS files, keeping the previous configuration")
+	return r.config, nil
+}
+
+func (r *tlsReloader) fileRevisions() (string, error) {
+	revision := ""
+	for _, path := range []string{r.certFile, r.keyFile, r.clientCAFile} {
+		if path == "" {
+			continue
+		}
+
+		fileRevision, err := util.FileRevision(path)
+		if err != nil {
+			return "", err
+		}
+		revision += fileRevision + "/"
+	}
+
+	return revision, nil
+}
+
+func (r *tlsReloader) load() (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %v", err)
+	}
+
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{certificate},
+	}
+
+	if r.clientCAFile != "" {
+		b, err := os.ReadFile(r.clientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA bundle: %v", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("client CA bundle %s contains no certificate", r.clientCAFile)
+		}
+
+		// certificates are optional so that other authentication methods keep working
+		config.ClientCAs = pool
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return config, nil
+}
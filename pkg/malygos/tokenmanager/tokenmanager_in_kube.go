@@ -0,0 +1,520 @@
+package tokenmanager
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/nrz-incubator/malygos/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// TokenPrefix identifies Malygos API tokens among other bearer tokens
+	TokenPrefix = "mlg_"
+
+	// ServiceAccountPrefix is prepended to the service account names of tokens to build their username,
+	// apart from the Kubernetes service accounts so that a token can't act as one of them
+	ServiceAccountPrefix = "malygos:serviceaccount:"
+	// ServiceAccountsGroup is the group of every service account token
+	ServiceAccountsGroup = "malygos:serviceaccounts"
+
+	tokenLabel           = "malygos.local/api-token"
+	lastUsedAnnotation   = "malygos.local/last-used-at"
+	secretType           = "malygos.local/api-token"
+	secretNamePrefix     = "malygos-token-"
+	secretRecordKey      = "token"
+	tokenIDLength        = 10
+	tokenSecretLength    = 40
+	defaultTokenLifetime = 90 * 24 * time.Hour
+	lastUsedUpdatePeriod = time.Minute
+	maxTokenNameLength   = 253
+	// tokens are cached for a short while to not read their Secret on every request, deleting a
+	// token through another replica takes effect once the cache expires
+	tokenCacheTTL     = 30 * time.Second
+	tokenCacheMaxSize = 4096
+	// the groups of the owner tokens are refreshed at most once per period while they don't change
+	groupsRefreshPeriod = time.Hour
+	// tokens drop the groups of their owner when they weren't refreshed for this long, which
+	// happens when the owner no longer authenticates with another method
+	tokenGroupsLifetime = 7 * 24 * time.Hour
+)
+
+// tokenRecord is the content stored in the token Secret, the token secret itself is only kept hashed.
+type tokenRecord struct {
+	Name      string    `json:"name"`
+	Hash      string    `json:"hash"`
+	Owner     string    `json:"owner"`
+	Username  string    `json:"username"`
+	Groups    []string  `json:"groups,omitempty"`
+	Scopes    []string  `json:"scopes,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	// GroupsRefreshedAt is the last time Groups were set from the owner identity, CreatedAt when unset
+	GroupsRefreshedAt time.Time `json:"groupsRefreshedAt,omitempty"`
+}
+
+type cachedToken struct {
+	record  *tokenRecord
+	expires time.Time
+}
+
+type ownerRefresh struct {
+	groups string
+	at     time.Time
+}
+
+type InKubeTokenManager struct {
+	client    kubernetes.Interface
+	logger    logr.Logger
+	namespace string
+
+	lock     sync.Mutex
+	lastUsed map[string]time.Time
+	cache    map[string]cachedToken
+	owners   map[string]ownerRefresh
+	pruned   time.Time
+}
+
+func NewInKubeTokenManager(logger logr.Logger, client kubernetes.Interface, namespace string) *InKubeTokenManager {
+	return &InKubeTokenManager{
+		client:    client,
+		logger:    logger,
+		namespace: namespace,
+		lastUsed:  map[string]time.Time{},
+		cache:     map[string]cachedToken{},
+		owners:    map[string]ownerRefresh{},
+	}
+}
+
+func (m *InKubeTokenManager) Create(owner *api.UserInfo, request *api.TokenRequest) (*api.Token, error) {
+	if request.Name == "" || len(request.Name) > maxTokenNameLength {
+		return nil, errors.NewInvalidArgumentError("name field is required and must be shorter than 253 characters")
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	expiresAt := now.Add(defaultTokenLifetime)
+	if request.ExpiresAt != nil {
+		if !request.ExpiresAt.After(now) {
+			return nil, errors.NewInvalidArgumentError("expires_at field must be in the future")
+		}
+		expiresAt = request.ExpiresAt.UTC()
+	}
+
+	var scopes []string
+	if request.Scopes != nil && len(*request.Scopes) > 0 {
+		for _, scope := range *request.Scopes {
+			action, resource, ok := strings.Cut(scope, ":")
+			if scope != "*" && (!ok || action == "" || resource == "") {
+				return nil, errors.NewInvalidArgumentError(fmt.Sprintf("scope %s must be in the action:resource form", scope))
+			}
+
+			// a restricted token can't be used to create a more powerful one
+			if !owner.HasScope(action, resource) || (scope == "*" && owner.Scopes != nil) {
+				return nil, errors.NewInvalidArgumentError(fmt.Sprintf("scope %s exceeds the caller scopes", scope))
+			}
+		}
+		scopes = *request.Scopes
+	} else if owner.Scopes != nil {
+		scopes = owner.Scopes
+	}
+
+	record := tokenRecord{
+		Name:      request.Name,
+		Owner:     owner.Username,
+		Username:  owner.Username,
+		Groups:    owner.Groups,
+		Scopes:    scopes,
+		CreatedAt: now,
+		ExpiresAt: expiresAt,
+
+		GroupsRefreshedAt: now,
+	}
+
+	if request.ServiceAccount != nil && *request.ServiceAccount != "" {
+		if errs := validation.IsDNS1123Label(*request.ServiceAccount); len(errs) > 0 {
+			return nil, errors.NewInvalidArgumentError("service_account field is invalid: " + strings.Join(errs, ", "))
+		}
+
+		record.Username = ServiceAccountPrefix + *request.ServiceAccount
+		record.Groups = []string{ServiceAccountsGroup}
+	}
+
+	id := util.GenerateRandomString(tokenIDLength)
+	secret := util.GenerateRandomString(tokenSecretLength)
+	record.Hash = hashSecret(secret)
+
+	b, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal token: %v", err)
+	}
+
+	_, err = m.client.CoreV1().Secrets(m.namespace).Create(context.TODO(), &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretNamePrefix + id,
+			Namespace: m.namespace,
+			Labels: map[string]string{
+				tokenLabel: "true",
+			},
+		},
+		Type: secretType,
+		Data: map[string][]byte{
+			secretRecordKey: b,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token secret: %v", err)
+	}
+
+	token := record.toAPI(id, nil)
+	token.Token = ptr.To(fmt.Sprintf("%s%s_%s", TokenPrefix, id, secret))
+	return token, nil
+}
+
+func (m *InKubeTokenManager) List(owner string) ([]api.Token, error) {
+	secrets, err := m.client.CoreV1().Secrets(m.namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: tokenLabel + "=true",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list token secrets: %v", err)
+	}
+
+	tokens := []api.Token{}
+	for _, secret := range secrets.Items {
+		record, err := decodeRecord(&secret)
+		if err != nil {
+			m.logger.Error(err, "ignoring malformed token secret", "secret", secret.Name)
+			continue
+		}
+
+		if record.Owner != owner {
+			continue
+		}
+
+		tokens = append(tokens, *record.toAPI(strings.TrimPrefix(secret.Name, secretNamePrefix), lastUsedAt(&secret)))
+	}
+
+	return tokens, nil
+}
+
+func (m *InKubeTokenManager) Delete(owner string, id string) error {
+	secret, record, err := m.get(id)
+	if err != nil {
+		return err
+	}
+
+	// tokens of other users are reported as missing to not disclose them
+	if record.Owner != owner {
+		return errors.NewNotFoundError("token", id)
+	}
+
+	err = m.client.CoreV1().Secrets(m.namespace).Delete(context.TODO(), secret.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &secret.UID},
+	})
+	m.forget(id)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return errors.NewNotFoundError("token", id)
+		}
+
+		return fmt.Errorf("failed to delete token secret: %v", err)
+	}
+
+	return nil
+}
+
+func (m *InKubeTokenManager) Authenticate(token string) (*api.UserInfo, error) {
+	id, secret, ok := strings.Cut(strings.TrimPrefix(token, TokenPrefix), "_")
+	if !strings.HasPrefix(token, TokenPrefix) || !ok || len(id) != tokenIDLength {
+		return nil, errors.NewUnauthorizedError("malformed API token")
+	}
+
+	record, err := m.cachedRecord(id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NewUnauthorizedError("unknown API token")
+		}
+
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(record.Hash), []byte(hashSecret(secret))) != 1 {
+		return nil, errors.NewUnauthorizedError("invalid API token")
+	}
+
+	if time.Now().After(record.ExpiresAt) {
+		return nil, errors.NewUnauthorizedError("API token is expired")
+	}
+
+	// service account tokens issued before they got their own prefix would act as Kubernetes service accounts
+	if strings.HasPrefix(record.Username, "system:") {
+		return nil, errors.NewUnauthorizedError("API token identity is no longer supported")
+	}
+
+	m.touch(id)
+
+	groups := record.Groups
+	if record.Username == record.Owner && time.Since(record.groupsRefreshedAt()) > tokenGroupsLifetime {
+		groups = nil
+	}
+
+	return &api.UserInfo{
+		Username: record.Username,
+		Groups:   groups,
+		Scopes:   record.Scopes,
+	}, nil
+}
+
+// RefreshGroups sets the groups of the owner tokens to the groups the owner authenticated with, in the
+// background. Tokens issued for service accounts keep their groups.
+func (m *InKubeTokenManager) RefreshGroups(owner *api.UserInfo) {
+	now := time.Now().UTC().Truncate(time.Second)
+	groups := append([]string{}, owner.Groups...)
+	slices.Sort(groups)
+	key := strings.Join(groups, ",")
+
+	m.lock.Lock()
+	if last, ok := m.owners[owner.Username]; ok && last.groups == key && now.Sub(last.at) < groupsRefreshPeriod {
+		m.lock.Unlock()
+		return
+	}
+	m.owners[owner.Username] = ownerRefresh{groups: key, at: now}
+	m.pruneLocked(now)
+	m.lock.Unlock()
+
+	go func() {
+		if err := m.refreshGroups(owner.Username, groups, now); err != nil {
+			m.logger.Error(err, "failed to refresh token groups", "owner", owner.Username)
+
+			// retry on the next authentication
+			m.lock.Lock()
+			delete(m.owners, owner.Username)
+			m.lock.Unlock()
+		}
+	}()
+}
+
+func (m *InKubeTokenManager) refreshGroups(owner string, groups []string, now time.Time) error {
+	secrets, err := m.client.CoreV1().Secrets(m.namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: tokenLabel + "=true",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list token secrets: %v", err)
+	}
+
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		record, err := decodeRecord(secret)
+		if err != nil || record.Owner != owner || record.Username != owner {
+			continue
+		}
+
+		current := append([]string{}, record.Groups...)
+		slices.Sort(current)
+		if slices.Equal(current, groups) && now.Sub(record.groupsRefreshedAt()) < groupsRefreshPeriod {
+			continue
+		}
+
+		record.Groups = groups
+		record.GroupsRefreshedAt = now
+		b, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("failed to marshal token: %v", err)
+		}
+
+		// the resource version of the listed secret guards against concurrent updates
+		secret.Data[secretRecordKey] = b
+		if _, err := m.client.CoreV1().Secrets(m.namespace).Update(context.TODO(), secret, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update token secret %s: %v", secret.Name, err)
+		}
+
+		m.forget(strings.TrimPrefix(secret.Name, secretNamePrefix))
+	}
+
+	return nil
+}
+
+// cachedRecord returns the record of a token, from the cache when it is recent enough
+func (m *InKubeTokenManager) cachedRecord(id string) (*tokenRecord, error) {
+	now := time.Now()
+
+	m.lock.Lock()
+	entry, ok := m.cache[id]
+	m.lock.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.record, nil
+	}
+
+	_, record, err := m.get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.pruneLocked(now)
+	if len(m.cache) < tokenCacheMaxSize {
+		m.cache[id] = cachedToken{record: record, expires: now.Add(tokenCacheTTL)}
+	}
+
+	return record, nil
+}
+
+func (m *InKubeTokenManager) forget(id string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.cache, id)
+}
+
+// pruneLocked drops the entries which no longer matter, at most once per usage update period
+func (m *InKubeTokenManager) pruneLocked(now time.Time) {
+	if now.Sub(m.pruned) < lastUsedUpdatePeriod {
+		return
+	}
+	m.pruned = now
+
+	for id, entry := range m.cache {
+		if now.After(entry.expires) {
+			delete(m.cache, id)
+		}
+	}
+
+	for id, last := range m.lastUsed {
+		if now.Sub(last) >= lastUsedUpdatePeriod {
+			delete(m.lastUsed, id)
+		}
+	}
+
+	for owner, last := range m.owners {
+		if now.Sub(last.at) >= groupsRefreshPeriod {
+			delete(m.owners, owner)
+		}
+	}
+}
+
+func (m *InKubeTokenManager) get(id string) (*v1.Secret, *tokenRecord, error) {
+	secret, err := m.client.CoreV1().Secrets(m.namespace).Get(context.TODO(), secretNamePrefix+id, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil, errors.NewNotFoundError("token", id)
+		}
+
+		return nil, nil, fmt.Errorf("failed to get token secret: %v", err)
+	}
+
+	if secret.Type != secretType {
+		return nil, nil, errors.NewNotFoundError("token", id)
+	}
+
+	record, err := decodeRecord(secret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return secret, record, nil
+}
+
+// touch records the token usage, at most once per period to avoid a write on every request
+func (m *InKubeTokenManager) touch(id string) {
+	now := time.Now().UTC()
+
+	m.lock.Lock()
+	if last, ok := m.lastUsed[id]; ok && now.Sub(last) < lastUsedUpdatePeriod {
+		m.lock.Unlock()
+		return
+	}
+	m.lastUsed[id] = now
+	m.pruneLocked(now)
+	m.lock.Unlock()
+
+	go func() {
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]string{
+					lastUsedAnnotation: now.Format(time.RFC3339),
+				},
+			},
+		})
+		if err != nil {
+			m.logger.Error(err, "failed to marshal token last used patch")
+			return
+		}
+
+		_, err = m.client.CoreV1().Secrets(m.namespace).Patch(context.Background(), secretNamePrefix+id,
+			types.MergePatchType, patch, metav1.PatchOptions{})
+		if err != nil {
+			m.logger.Error(err, "failed to record token usage", "token", id)
+		}
+	}()
+}
+
+func decodeRecord(secret *v1.Secret) (*tokenRecord, error) {
+	record := &tokenRecord{}
+	if err := json.Unmarshal(secret.Data[secretRecordKey], record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal token %s: %v", secret.Name, err)
+	}
+
+	return record, nil
+}
+
+func lastUsedAt(secret *v1.Secret) *time.Time {
+	value, ok := secret.Annotations[lastUsedAnnotation]
+	if !ok {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+
+	return &t
+}
+
+func (r *tokenRecord) groupsRefreshedAt() time.Time {
+	if r.GroupsRefreshedAt.IsZero() {
+		return r.CreatedAt
+	}
+
+	return r.GroupsRefreshedAt
+}
+
+func (r *tokenRecord) toAPI(id string, lastUsed *time.Time) *api.Token {
+	token := &api.Token{
+		Id:         id,
+		Name:       r.Name,
+		Owner:      r.Owner,
+		Username:   r.Username,
+		CreatedAt:  r.CreatedAt,
+		ExpiresAt:  r.ExpiresAt,
+		LastUsedAt: lastUsed,
+	}
+
+	if r.Scopes != nil {
+		token.Scopes = ptr.To(r.Scopes)
+	}
+
+	return token
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
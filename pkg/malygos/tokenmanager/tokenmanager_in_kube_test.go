@@ -0,0 +1,111 @@
+package tokenmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nrz-incubator/malygos/pkg/api"
+	"github.com/nrz-incubator/malygos/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_InKubeTokenManager(t *testing.T) {
+	m := NewInKubeTokenManager(logr.Discard(), fake.NewSimpleClientset(), "malygos")
+	alice := &api.UserInfo{Username: "alice", Groups: []string{"ops"}}
+
+	token, err := m.Create(alice, &api.TokenRequest{Name: "ci", Scopes: &[]string{"list:cluster"}})
+	require.NoError(t, err)
+	require.NotNil(t, token.Token)
+	assert.Equal(t, "alice", token.Owner)
+
+	user, err := m.Authenticate(*token.Token)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", user.Username)
+	assert.Equal(t, []string{"ops"}, user.Groups)
+	assert.True(t, user.HasScope("list", "cluster"))
+	assert.False(t, user.HasScope("delete", "cluster"))
+
+	_, err = m.Authenticate(*token.Token + "x")
+	assert.True(t, errors.IsUnauthorized(err))
+	_, err = m.Authenticate("mlg_unknown")
+	assert.True(t, errors.IsUnauthorized(err))
+
+	// a scoped token can't create a token with more permissions
+	_, err = m.Create(user, &api.TokenRequest{Name: "escalation", Scopes: &[]string{"*"}})
+	assert.True(t, errors.IsInvalidArgument(err))
+
+	past := time.Now().Add(-time.Hour)
+	_, err = m.Create(alice, &api.TokenRequest{Name: "expired", ExpiresAt: &past})
+	assert.True(t, errors.IsInvalidArgument(err))
+
+	sa := "deployer"
+	saToken, err := m.Create(alice, &api.TokenRequest{Name: "deployer", ServiceAccount: &sa})
+	require.NoError(t, err)
+	saUser, err := m.Authenticate(*saToken.Token)
+	require.NoError(t, err)
+	assert.Equal(t, "malygos:serviceaccount:deployer", saUser.Username)
+	assert.Equal(t, []string{ServiceAccountsGroup}, saUser.Groups)
+	assert.Nil(t, saUser.Scopes)
+
+	invalid := "kube-system:default"
+	_, err = m.Create(alice, &api.TokenRequest{Name: "invalid", ServiceAccount: &invalid})
+	assert.True(t, errors.IsInvalidArgument(err))
+
+	tokens, err := m.List("alice")
+	require.NoError(t, err)
+	assert.Len(t, tokens, 2)
+	for _, listed := range tokens {
+		assert.Nil(t, listed.Token, "token secrets must not be listed")
+	}
+
+	tokens, err = m.List("bob")
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	assert.True(t, errors.IsNotFound(m.Delete("bob", token.Id)))
+	require.NoError(t, m.Delete("alice", token.Id))
+	_, err = m.Authenticate(*token.Token)
+	assert.True(t, errors.IsUnauthorized(err))
+}
+
+func Test_InKubeTokenManagerRefreshGroups(t *testing.T) {
+	m := NewInKubeTokenManager(logr.Discard(), fake.NewSimpleClientset(), "malygos")
+	alice := &api.UserInfo{Username: "alice", Groups: []string{"ops", "admins"}}
+
+	token, err := m.Create(alice, &api.TokenRequest{Name: "ci"})
+	require.NoError(t, err)
+	sa := "deployer"
+	saToken, err := m.Create(alice, &api.TokenRequest{Name: "deployer", ServiceAccount: &sa})
+	require.NoError(t, err)
+
+	// alice left the admins group
+	m.RefreshGroups(&api.UserInfo{Username: "alice", Groups: []string{"ops"}})
+	assert.Eventually(t, func() bool {
+		_, record, err := m.get(token.Id)
+		return err == nil && assert.ObjectsAreEqual([]string{"ops"}, record.Groups)
+	}, time.Second, 10*time.Millisecond)
+
+	user, err := m.Authenticate(*token.Token)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ops"}, user.Groups)
+
+	saUser, err := m.Authenticate(*saToken.Token)
+	require.NoError(t, err)
+	assert.Equal(t, []string{ServiceAccountsGroup}, saUser.Groups)
+
+	// tokens drop groups which were not refreshed for too long
+	_, record, err := m.get(token.Id)
+	require.NoError(t, err)
+	record.GroupsRefreshedAt = time.Now().Add(-tokenGroupsLifetime - time.Hour)
+	m.lock.Lock()
+	m.cache[token.Id] = cachedToken{record: record, expires: time.Now().Add(time.Minute)}
+	m.lock.Unlock()
+
+	user, err = m.Authenticate(*token.Token)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", user.Username)
+	assert.Empty(t, user.Groups)
+}
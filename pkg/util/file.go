@@ -0,0 +1,17 @@
+package util
+
+import (
+	"fmt"
+	"os"
+)
+
+// FileRevision returns a string changing whenever the file is modified,
+// suitable to detect when a configuration file must be reloaded.
+func FileRevision(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d-%d", info.ModTime().UnixNano(), info.Size()), nil
+}